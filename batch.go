@@ -0,0 +1,107 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// 批量验证单条结果
+type ValidationResult struct {
+	Index     int        // 数据在输入通道中的序号，从0开始
+	Validator *Validator // 验证器，可获取全部验证错误
+	Err       error      // 验证错误第一项，验证通过时为nil
+}
+
+/**
+ * 流式批量验证，从 items 读取数据逐条验证并将结果发送到返回的通道
+ * 调用方在数据发送完毕后关闭 items，所有结果发送完毕后返回的通道会被关闭
+ * 验证规则存在循环依赖，或使用 WithStrictRuleNames(false) 时存在不存在的规则，直接返回错误，
+ * 验证过程中的其他panic会通过 ValidationResult.Err 返回，不会导致进程退出
+ *
+ * @param items <-chan map[string][]string 验证数据
+ * @param rules 验证规则
+ * @param opts 可选配置项
+ * @return <-chan ValidationResult, error 验证规则为空或配置错误时返回错误
+ */
+func ValidateChannel(items <-chan map[string][]string, rules interface{}, opts ...Option) (<-chan ValidationResult, error) {
+	fmtRules := formatRules(rules)
+	if len(fmtRules) == 0 {
+		return nil, errors.New("验证规则不存在")
+	}
+	if err := checkRules(fmtRules, opts); err != nil {
+		return nil, err
+	}
+
+	results := make(chan ValidationResult)
+	go func() {
+		defer close(results)
+		index := 0
+		for data := range items {
+			validator, err := validateItem(data, fmtRules, opts)
+			results <- ValidationResult{Index: index, Validator: validator, Err: err}
+			index++
+		}
+	}()
+	return results, nil
+}
+
+/**
+ * 验证单条数据，panic转换为错误返回
+ *
+ * @param data map[string][]string 验证数据
+ * @param rules map[string][]string 验证规则
+ * @param opts []Option 可选配置项
+ * @return Validator, error
+ */
+func validateItem(data map[string][]string, rules map[string][]string, opts []Option) (validator *Validator, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("validator panic: %v", r)
+		}
+	}()
+	return New(data, rules, opts...)
+}
+
+/**
+ * 检测验证规则配置，包括循环依赖以及不允许时的不存在规则，避免在验证协程中panic
+ *
+ * @param rules map[string][]string 验证规则
+ * @param opts []Option 可选配置项
+ * @return error
+ */
+func checkRules(rules map[string][]string, opts []Option) (err error) {
+	v := &Validator{rules: rules, recoverRule: defaultRecoverRule}
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	v.sortFields()
+
+	if v.recoverRule {
+		return nil
+	}
+	for key, item := range rules {
+		for _, rule := range item {
+			ruleName, param := splitRule(rule)
+			names := []string{ruleName}
+			if ucfirst(ruleName) == "Or" {
+				for _, subRule := range strings.Split(param, ",") {
+					subName, _ := splitRule(subRule)
+					names = append(names, subName)
+				}
+			}
+			for _, name := range names {
+				if !ruleExists(name) {
+					return fmt.Errorf("the valid rule %s of field %s not exist", name, key)
+				}
+			}
+		}
+	}
+	return nil
+}
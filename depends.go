@@ -0,0 +1,83 @@
+package validator
+
+import (
+	"sort"
+	"strings"
+)
+
+/**
+ * 获取字段通过 depends:field1,field2 声明的依赖字段
+ *
+ * @param key {string} 验证字段
+ * @return []string
+ */
+func (v *Validator) dependencies(key string) []string {
+	var fields []string
+	for _, rule := range v.rules[key] {
+		ruleName, param := splitRule(rule)
+		if ucfirst(ruleName) == "Depends" && len(param) > 0 {
+			fields = append(fields, strings.Split(param, ",")...)
+		}
+	}
+	return fields
+}
+
+/**
+ * 检测依赖字段是否已存在验证错误，存在则跳过当前字段验证
+ *
+ * @param key {string} 验证字段
+ * @return bool
+ */
+func (v *Validator) dependencyFailed(key string) bool {
+	for _, field := range v.dependencies(key) {
+		if v.existError(field) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * 按依赖关系对验证字段进行拓扑排序，被依赖的字段先验证
+ * 无依赖关系的字段按字段名排序，存在循环依赖时panic
+ *
+ * @return []string
+ */
+func (v *Validator) sortFields() []string {
+	keys := make([]string, 0, len(v.rules))
+	for key := range v.rules {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(keys))
+	sorted := make([]string, 0, len(keys))
+
+	var visit func(key string)
+	visit = func(key string) {
+		switch state[key] {
+		case visited:
+			return
+		case visiting:
+			panic("the field " + key + " has circular depends")
+		}
+		state[key] = visiting
+		for _, field := range v.dependencies(key) {
+			if _, ok := v.rules[field]; ok {
+				visit(field)
+			}
+		}
+		state[key] = visited
+		sorted = append(sorted, key)
+	}
+
+	for _, key := range keys {
+		visit(key)
+	}
+	return sorted
+}
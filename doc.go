@@ -0,0 +1,182 @@
+package validator
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// 验证规则说明
+type RuleDoc struct {
+	Name        string // 规则名称
+	Description string // 规则描述
+	ParamFormat string // 参数格式，为空表示无参数
+	Example     string // 使用示例
+	Idempotent  bool   // 相同数据重复验证结果是否一致，由 SetRuleIdempotent 设置，读取时从规则注册表获取
+}
+
+// 内置验证规则说明，key为小写规则名称
+var (
+	ruleDocs    = map[string]RuleDoc{}
+	ruleDocLock sync.RWMutex
+)
+
+func init() {
+	builtin := [][4]string{
+		{"required", "验证数据必填，默认即required，一般不需要配置", "", "required"},
+		{"min", "验证字符串最小长度，支持多字节字符", "最小长度", "min:1"},
+		{"max", "验证字符串最大长度，支持多字节字符", "最大长度", "max:10"},
+		{"regex", "正则表达式验证", "正则表达式", "regex:^1[0-9]{10}$"},
+		{"int", "验证数据是否为整数", "", "int"},
+		{"numeric", "验证数据是否为数字串", "", "numeric"},
+		{"nullable", "验证数据可选，数据不存在或为空值时跳过后续验证", "", "nullable"},
+		{"bail", "当前字段遇到第一个验证错误后停止该字段后续验证", "", "bail"},
+		{"trim", "去除首尾空白字符，后续规则使用去除空白后的值", "", "trim"},
+		{"email", "验证数据是否为合法邮箱", "", "email"},
+		{"url", "验证数据是否为合法url地址", "", "url"},
+		{"mobile", "大陆11位手机号验证", "", "mobile"},
+		{"in", "验证数据是否为指定值之一", "逗号分隔的可选值", "in:a,b,c"},
+		{"lt", "验证整数小于指定值", "整数", "lt:100"},
+		{"lte", "验证整数小于等于指定值", "整数", "lte:100"},
+		{"gt", "验证整数大于指定值", "整数", "gt:0"},
+		{"gte", "验证整数大于等于指定值", "整数", "gte:18"},
+		{"odd", "验证数据是否为奇数", "", "odd"},
+		{"even", "验证数据是否为偶数", "", "even"},
+		{"hexstring", "验证数据是否为十六进制字符串", "可选，字符串长度", "hexstring:64"},
+		{"creditcard", "验证信用卡卡号，包括卡组织号段、长度以及Luhn校验", "可选，逗号分隔的卡组织(visa,mastercard,amex,discover)", "creditcard:visa,mastercard"},
+		{"iban", "验证国际银行账号(IBAN)，包括mod-97校验", "可选，逗号分隔的国家代码", "iban:DE,FR"},
+		{"bic", "验证SWIFT/BIC代码", "可选，strict 严格模式", "bic:strict"},
+		{"ean", "验证EAN商品条码", "可选，条码位数 8 或 13", "ean:13"},
+		{"isbn", "验证ISBN书号", "可选，书号位数 10 或 13", "isbn:13"},
+		{"ssn", "验证美国社会安全号码", "可选，strict 严格模式", "ssn:strict"},
+		{"htmlfree", "验证数据不包含HTML标签", "可选，strict 同时拒绝HTML实体", "htmlfree:strict"},
+		{"nosqlinjection", "验证数据不包含常见SQL注入特征", "", "nosqlinjection"},
+		{"strongentropy", "验证密码香农熵(比特/字符)不低于阈值", "熵阈值", "strongentropy:3.5"},
+		{"commonpassword", "验证密码不在常见弱密码列表中", "", "commonpassword"},
+		{"jwtformat", "验证数据为结构正确的JWT，不校验签名", "可选，header中的alg算法", "jwtformat:hs256"},
+		{"xmlsafe", "验证数据为格式正确的XML", "可选，noentity 同时拒绝实体声明", "xmlsafe:noentity"},
+		{"intersection", "验证多值字段中至少有一项在指定集合中", "逗号分隔的集合", "intersection:admin,editor"},
+		{"superset", "验证多值字段包含指定集合中的所有项", "逗号分隔的集合", "superset:read,write"},
+		{"ipinrange", "验证IP地址在指定网段内", "CIDR网段", "ipinrange:10.0.0.0/8"},
+		{"latitude", "验证纬度，取值范围 -90 ~ 90", "可选，precision:N 最多N位小数", "latitude:precision:6"},
+		{"longitude", "验证经度，取值范围 -180 ~ 180", "可选，precision:N 最多N位小数", "longitude:precision:6"},
+		{"atleastone", "分组中至少有一个字段存在非空值，分组通过 RegisterFieldGroup 注册", "分组名称", "atleastone:contact"},
+		{"ltfield", "验证数值或日期小于指定字段", "比较字段名称", "ltfield:price_regular"},
+		{"ltefield", "验证数值或日期小于等于指定字段", "比较字段名称", "ltefield:end_date"},
+		{"gtfield", "验证数值或日期大于指定字段", "比较字段名称", "gtfield:min_price"},
+		{"gtefield", "验证数值或日期大于等于指定字段", "比较字段名称", "gtefield:start_date"},
+		{"same", "验证与指定字段的值相同", "比较字段名称", "same:shipping_city"},
+		{"different", "验证与指定字段的值不同", "比较字段名称", "different:current_password"},
+		{"or", "任意一个子规则验证通过即通过", "逗号分隔的子规则", "or:email,mobile"},
+		{"depends", "依赖字段存在验证错误时跳过当前字段验证", "逗号分隔的依赖字段", "depends:date_start"},
+	}
+	for _, item := range builtin {
+		RegisterRuleDoc(item[0], item[1], item[2], item[3])
+	}
+}
+
+/**
+ * 注册验证规则说明，已存在的规则说明会被覆盖，不会影响规则是否幂等，可以在验证过程中并发调用
+ *
+ * @param name string 规则名称
+ * @param description string 规则描述
+ * @param paramFormat string 参数格式
+ * @param example string 使用示例
+ */
+func RegisterRuleDoc(name string, description string, paramFormat string, example string) {
+	ruleDocLock.Lock()
+	defer ruleDocLock.Unlock()
+	ruleDocs[strings.ToLower(name)] = RuleDoc{
+		Name:        strings.ToLower(name),
+		Description: description,
+		ParamFormat: paramFormat,
+		Example:     example,
+	}
+}
+
+/**
+ * 获取验证规则说明，规则名称不区分大小写
+ *
+ * @param name string 规则名称
+ * @return RuleDoc, bool
+ */
+func GetRuleDoc(name string) (RuleDoc, bool) {
+	ruleDocLock.RLock()
+	doc, ok := ruleDocs[strings.ToLower(name)]
+	ruleDocLock.RUnlock()
+	if ok {
+		doc.Idempotent = isIdempotentRule(doc.Name)
+	}
+	return doc, ok
+}
+
+/**
+ * 获取所有验证规则说明，按规则名称排序
+ *
+ * @return []RuleDoc
+ */
+func ListRules() []RuleDoc {
+	ruleDocLock.RLock()
+	docs := make([]RuleDoc, 0, len(ruleDocs))
+	for _, doc := range ruleDocs {
+		docs = append(docs, doc)
+	}
+	ruleDocLock.RUnlock()
+	for i := range docs {
+		docs[i].Idempotent = isIdempotentRule(docs[i].Name)
+	}
+	sort.Slice(docs, func(i, j int) bool {
+		return docs[i].Name < docs[j].Name
+	})
+	return docs
+}
+
+/**
+ * 根据验证规则说明生成Markdown表格格式的字段约束文档，字段按名称排序
+ *
+ *     | 字段 | 必填 | 约束 |
+ *     |:---- |:---- |:---- |
+ *     | name | 是 | `min:1` 验证字符串最小长度，支持多字节字符; `max:10` 验证字符串最大长度，支持多字节字符 |
+ *
+ * @param rules map[string][]string 验证规则
+ * @return string
+ */
+func ExplainRules(rules map[string][]string) string {
+	fields := make([]string, 0, len(rules))
+	for field := range rules {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var buf strings.Builder
+	buf.WriteString("| 字段 | 必填 | 约束 |\n")
+	buf.WriteString("|:---- |:---- |:---- |\n")
+	for _, field := range fields {
+		item := rules[field]
+		required := "是"
+		if !inArray(item, "required") && (inArray(item, "nullable") || hasImplicitRule(item)) {
+			required = "否"
+		}
+
+		var constraints []string
+		for _, rule := range item {
+			ruleName, _ := splitRule(rule)
+			if ruleName == "required" || ruleName == "nullable" {
+				continue
+			}
+			constraint := "`" + rule + "`"
+			if doc, ok := GetRuleDoc(ruleName); ok {
+				constraint += " " + doc.Description
+			}
+			constraints = append(constraints, constraint)
+		}
+
+		buf.WriteString("| " + escapeMarkdownCell(field) + " | " + required + " | " + escapeMarkdownCell(strings.Join(constraints, "; ")) + " |\n")
+	}
+	return buf.String()
+}
+
+// 转义Markdown表格单元格中的竖线，例如正则规则中的 |
+func escapeMarkdownCell(str string) string {
+	return strings.Replace(str, "|", "\\|", -1)
+}
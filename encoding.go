@@ -0,0 +1,61 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+// 验证器序列化格式，用于缓存验证结果
+type validatorGob struct {
+	Data        map[string][]string
+	Rules       map[string][]string
+	CustomMsg   map[string]CustomMsgElem
+	Message     map[string]string
+	CustomCode  map[string]CustomCodeElem
+	ValidErrors []ValidError
+	Warnings    []ValidError
+}
+
+/**
+ * 使用gob序列化验证器，包括验证数据、规则、自定义错误以及验证结果
+ *
+ * @return []byte, error
+ */
+func (v *Validator) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(validatorGob{
+		Data:        v.data,
+		Rules:       v.rules,
+		CustomMsg:   v.customMsg,
+		Message:     v.message,
+		CustomCode:  v.customCode,
+		ValidErrors: v.ValidErrors,
+		Warnings:    v.Warnings,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+/**
+ * 从gob序列化数据中恢复验证器
+ *
+ * @param data []byte MarshalBinary 返回的数据
+ * @return error
+ */
+func (v *Validator) UnmarshalBinary(data []byte) error {
+	var item validatorGob
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&item); err != nil {
+		return err
+	}
+	v.data = item.Data
+	v.recoverRule = defaultRecoverRule // 与 New 保持一致的默认配置
+	v.rules = item.Rules
+	v.customMsg = item.CustomMsg
+	v.message = item.Message
+	v.customCode = item.CustomCode
+	v.ValidErrors = item.ValidErrors
+	v.Warnings = item.Warnings
+	return nil
+}
@@ -0,0 +1,24 @@
+package validator
+
+import "strings"
+
+/**
+ * 验证环境变量，例如 os.Environ() 的返回值
+ * 每一项格式为 KEY=VALUE，不存在的环境变量按字段缺失处理
+ *
+ * @param env []string 环境变量
+ * @param rules map[string][]string 验证规则
+ * @param opts 可选配置项
+ * @return Validator, error 默认返回验证错误第一项
+ */
+func ValidateEnvironment(env []string, rules map[string][]string, opts ...Option) (*Validator, error) {
+	data := make(map[string][]string, len(env))
+	for _, item := range env {
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) != 2 || len(kv[0]) == 0 {
+			continue
+		}
+		data[kv[0]] = append(data[kv[0]], kv[1])
+	}
+	return New(data, rules, opts...)
+}
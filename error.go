@@ -0,0 +1,174 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// 单条错误的JSON输出格式
+type errorItem struct {
+	Message string `json:"message"`
+	Code    string `json:"code,omitempty"`
+}
+
+/**
+ * 序列化验证错误，每一条错误同时输出错误提示和错误码
+ *
+ * {"field":"name","errors":{"min":{"message":"...","code":"FIELD_TOO_SHORT"}}}
+ *
+ * @return []byte, error
+ */
+func (e ValidError) MarshalJSON() ([]byte, error) {
+	items := make(map[string]errorItem, len(e.Errors))
+	for key, msg := range e.Errors {
+		items[key] = errorItem{Message: msg, Code: e.ErrorCodes[key]}
+	}
+
+	return json.Marshal(struct {
+		Field  string               `json:"field"`
+		Errors map[string]errorItem `json:"errors"`
+	}{Field: e.Field, Errors: items})
+}
+
+// 认证相关字段名称关键字
+var authFieldKeywords = []string{"auth", "token", "jwt", "api_key", "apikey", "session"}
+
+/**
+ * 根据验证规则推荐HTTP状态码，仅作为参考，调用方可以自行覆盖
+ *
+ * | 错误                                   | 状态码 |
+ * |:-------------------------------------- |:------ |
+ * | timeout 验证超时                        | 503    |
+ * | 认证字段(auth、token等)缺失或格式错误     | 401    |
+ * | required 必填或字段缺失                  | 422    |
+ * | 其他格式、取值范围错误                    | 400    |
+ *
+ * @return int
+ */
+func (e ValidError) HTTPStatus() int {
+	if _, ok := e.Errors["timeout"]; ok {
+		return http.StatusServiceUnavailable
+	}
+
+	// 字段缺失时仅包含 def 错误
+	_, hasDef := e.Errors["def"]
+	_, hasRequired := e.Errors["required"]
+	missing := hasRequired || hasDef && len(e.Errors) == 1
+
+	field := strings.ToLower(e.Field)
+	for _, keyword := range authFieldKeywords {
+		if strings.Contains(field, keyword) {
+			if _, ok := e.Errors["jwtformat"]; ok || missing {
+				return http.StatusUnauthorized
+			}
+		}
+	}
+
+	if missing {
+		return http.StatusUnprocessableEntity
+	}
+	return http.StatusBadRequest
+}
+
+/**
+ * 获取每个字段的第一条错误提示，用于表单渲染
+ * 优先使用 def 错误提示，其次按字段验证规则的顺序获取
+ *
+ * @return map[string]string key为验证字段
+ */
+func (v *Validator) ToFormErrors() map[string]string {
+	formErrors := make(map[string]string, len(v.ValidErrors))
+	for _, item := range v.ValidErrors {
+		if msg, ok := item.Errors["def"]; ok {
+			formErrors[item.Field] = msg
+			continue
+		}
+		for _, rule := range v.rules[item.Field] {
+			ruleName, _ := splitRule(rule)
+			if msg, ok := item.Errors[ruleName]; ok {
+				formErrors[item.Field] = msg
+				break
+			}
+		}
+		if _, ok := formErrors[item.Field]; ok {
+			continue
+		}
+		// 不在验证规则中的错误，例如 timeout、validate
+		keys := make([]string, 0, len(item.Errors))
+		for key := range item.Errors {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		if len(keys) > 0 {
+			formErrors[item.Field] = item.Errors[keys[0]]
+		}
+	}
+	return formErrors
+}
+
+/**
+ * 获取所有字段的错误条数
+ *
+ * @return int
+ */
+func (v *Validator) CountErrors() int {
+	count := 0
+	for _, item := range v.ValidErrors {
+		count += countErrors(item)
+	}
+	return count
+}
+
+/**
+ * 获取单个字段的错误条数
+ *
+ * @param field string 验证字段
+ * @return int
+ */
+func (v *Validator) CountErrorsForField(field string) int {
+	if index := v.existError(field); index >= 0 {
+		return countErrors(v.ValidErrors[index])
+	}
+	return 0
+}
+
+// 获取字段错误条数，def 为字段默认错误提示，存在具体规则错误时不重复计数
+func countErrors(item ValidError) int {
+	if _, ok := item.Errors["def"]; ok && len(item.Errors) > 1 {
+		return len(item.Errors) - 1
+	}
+	return len(item.Errors)
+}
+
+/**
+ * 输出验证器摘要，便于调试，例如：
+ * Validator{fields:5, rules:12, errors:2 [email:required, age:min]}
+ *
+ * @return string
+ */
+func (v *Validator) String() string {
+	ruleCount := 0
+	for _, item := range v.rules {
+		ruleCount += len(item)
+	}
+
+	var items []string
+	for _, item := range v.ValidErrors {
+		keys := make([]string, 0, len(item.Errors))
+		for key := range item.Errors {
+			// def 为字段默认错误提示，存在具体规则错误时不重复输出
+			if key != "def" || len(item.Errors) == 1 {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			items = append(items, item.Field+":"+key)
+		}
+	}
+
+	return fmt.Sprintf("Validator{fields:%d, rules:%d, errors:%d [%s]}", len(v.rules), ruleCount, len(items), strings.Join(items, ", "))
+}
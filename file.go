@@ -0,0 +1,56 @@
+package validator
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+/**
+ * 验证INI/properties配置文件
+ *
+ * 每行格式为 KEY=VALUE，忽略空行以及 # 或 // 开头的注释行
+ * INI分组 [section] 下的字段名称为 section.KEY，同名字段多次出现时视为多个值
+ *
+ * @param path string 文件路径
+ * @param rules map[string][]string 验证规则
+ * @param opts 可选配置项
+ * @return Validator, error 文件读取或解析失败时返回的Validator为nil
+ */
+func ValidateFile(path string, rules map[string][]string, opts ...Option) (*Validator, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data := make(map[string][]string)
+	section := ""
+	scanner := bufio.NewScanner(file)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+
+		item := strings.SplitN(line, "=", 2)
+		if len(item) != 2 || len(strings.TrimSpace(item[0])) == 0 {
+			return nil, fmt.Errorf("%s:%d: invalid line, expect KEY=VALUE", path, lineNum)
+		}
+		key := strings.TrimSpace(item[0])
+		if len(section) > 0 {
+			key = section + "." + key
+		}
+		data[key] = append(data[key], strings.TrimSpace(item[1]))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return New(data, rules, opts...)
+}
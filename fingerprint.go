@@ -0,0 +1,74 @@
+package validator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"sort"
+)
+
+/**
+ * 计算验证规则、自定义错误以及错误码的SHA-256摘要，可作为验证结果缓存的key
+ * 相同规则和自定义错误的验证器返回相同的摘要，与验证数据无关
+ *
+ * @return string
+ */
+func (v *Validator) Fingerprint() string {
+	h := sha256.New()
+
+	fmt.Fprint(h, "rules\n")
+	keys := make([]string, 0, len(v.rules))
+	for key := range v.rules {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(h, "%q:%q\n", key, v.rules[key])
+	}
+
+	// 使用原始自定义错误，customMsg 仅包含验证数据中存在的字段，会受验证数据影响
+	fmt.Fprint(h, "messages\n")
+	keys = make([]string, 0, len(v.message))
+	for key := range v.message {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(h, "%q:%q\n", key, v.message[key])
+	}
+
+	fmt.Fprint(h, "codes\n")
+	codes := make(map[string]map[string]string, len(v.customCode))
+	for field, item := range v.customCode {
+		codes[field] = item
+	}
+	writeNestedMap(h, codes)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+/**
+ * 按key排序写入二级map，保证摘要稳定
+ *
+ * @param h hash.Hash
+ * @param nested map[string]map[string]string
+ */
+func writeNestedMap(h hash.Hash, nested map[string]map[string]string) {
+	fields := make([]string, 0, len(nested))
+	for field := range nested {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		item := nested[field]
+		keys := make([]string, 0, len(item))
+		for key := range item {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(h, "%q.%q:%q\n", field, key, item[key])
+		}
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/ntt360/validator
 
-go 1.13
+go 1.21
@@ -0,0 +1,46 @@
+package validator
+
+import "sync"
+
+// 字段分组，key为分组名称
+var (
+	fieldGroups    = map[string][]string{}
+	fieldGroupLock sync.RWMutex
+)
+
+/**
+ * 注册字段分组，用于 atleastone 等分组验证规则，已存在的分组会被覆盖，可以在验证过程中并发调用
+ *
+ * @param name string 分组名称
+ * @param fields []string 分组字段
+ */
+func RegisterFieldGroup(name string, fields []string) {
+	fieldGroupLock.Lock()
+	defer fieldGroupLock.Unlock()
+	fieldGroups[name] = append([]string{}, fields...)
+}
+
+/**
+ * 验证分组中至少有一个字段存在非空值，例如 atleastone:contact
+ *
+ * @param value 需要验证的值
+ * @param param 分组名称
+ * @param data 全部验证数据
+ * @return bool
+ */
+func atLeastOne(_ []string, param string, data map[string][]string) bool {
+	fieldGroupLock.RLock()
+	fields, ok := fieldGroups[param]
+	fieldGroupLock.RUnlock()
+	if !ok {
+		return false
+	}
+	for _, field := range fields {
+		for _, item := range data[field] {
+			if len(item) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
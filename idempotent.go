@@ -0,0 +1,122 @@
+package validator
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// 幂等验证缓存的验证结果
+type idempotentResult struct {
+	hash        string
+	validErrors []ValidError
+	warnings    []ValidError
+	err         error
+}
+
+/**
+ * 设置验证规则是否为幂等规则，非幂等规则不会使用 WithIdempotencyCheck 缓存的验证结果，可以在验证过程中并发调用
+ *
+ * @param name string 规则名称
+ * @param idempotent bool
+ */
+func SetRuleIdempotent(name string, idempotent bool) {
+	nonIdempotentLock.Lock()
+	defer nonIdempotentLock.Unlock()
+	if idempotent {
+		delete(nonIdempotentRules, ucfirst(name))
+	} else {
+		nonIdempotentRules[ucfirst(name)] = true
+	}
+}
+
+/**
+ * 检测验证规则是否为幂等规则
+ *
+ * @param name string 规则名称
+ * @return bool
+ */
+func isIdempotentRule(name string) bool {
+	nonIdempotentLock.RLock()
+	defer nonIdempotentLock.RUnlock()
+	return !nonIdempotentRules[ucfirst(name)]
+}
+
+/**
+ * 计算验证数据、规则、自定义错误以及字段分组、错误提示语言的MD5摘要
+ *
+ * @return string
+ */
+func (v *Validator) contentHash() string {
+	h := md5.New()
+	writeSortedSliceMap(h, v.data)
+	writeSortedSliceMap(h, v.rules)
+	writeSortedMap(h, v.message)
+
+	// 字段分组以及错误提示语言为全局注册，变化后同样需要重新验证
+	fieldGroupLock.RLock()
+	writeSortedSliceMap(h, fieldGroups)
+	fieldGroupLock.RUnlock()
+	fmt.Fprintf(h, "locale:%q\n", v.locale)
+	localeLock.RLock()
+	writeSortedMap(h, localeMap[v.locale])
+	writeSortedMap(h, localeMap[defaultLocale])
+	localeLock.RUnlock()
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// 按key排序写入 map[string]string
+func writeSortedMap(w io.Writer, item map[string]string) {
+	keys := make([]string, 0, len(item))
+	for key := range item {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(w, "%q:%q\n", key, item[key])
+	}
+	fmt.Fprint(w, "\n")
+}
+
+// 按key排序写入 map[string][]string
+func writeSortedSliceMap(w io.Writer, item map[string][]string) {
+	keys := make([]string, 0, len(item))
+	for key := range item {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(w, "%q:%q\n", key, item[key])
+	}
+	fmt.Fprint(w, "\n")
+}
+
+/**
+ * 检测所有验证规则是否均为幂等规则
+ * 不存在的规则、通过 SetRuleIdempotent 设置为非幂等的规则以及注册了限流函数的规则均视为非幂等规则
+ *
+ * @return bool
+ */
+func (v *Validator) rulesIdempotent() bool {
+	for _, item := range v.rules {
+		for _, rule := range item {
+			ruleName, param := splitRule(rule)
+			names := []string{ruleName}
+			if ucfirst(ruleName) == "Or" {
+				for _, subRule := range strings.Split(param, ",") {
+					subName, _ := splitRule(subRule)
+					names = append(names, subName)
+				}
+			}
+			for _, name := range names {
+				if _, throttled := getThrottle(name); !ruleExists(name) || !isIdempotentRule(name) || throttled {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+package validator
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/ntt360/validator/locales"
+)
+
+// 默认错误提示语言
+const defaultLocale = "en"
+
+// 错误提示语言包，key为验证规则名称(小写)，另外 def 为默认提示，missing 为字段缺失提示，unknown 为未知字段警告，
+// unknownrule 为验证规则不存在提示，throttle 为限流提示({param} 均为规则名称)
+// 提示中可以使用 {field}、{rule}、{param} 占位符
+var localeMap = map[string]map[string]string{
+	defaultLocale: {
+		"def":         "the field {field} not valid in {rule}",
+		"missing":     "the param {field} not valid!",
+		"unknown":     "the param {field} is not expected",
+		"unknownrule": "the valid rule {param} of field {field} not exist",
+		"throttle":    "the field {field} is validated too frequently, please try again later",
+	},
+	"ja-JP": locales.JaJP,
+	"es-ES": locales.EsES,
+}
+
+// 语言包读写锁
+var localeLock sync.RWMutex
+
+/**
+ * 注册错误提示语言包，已存在的语言会被覆盖，可以在验证过程中并发调用
+ * 错误提示会被复制，注册后修改 messages 不会生效
+ *
+ * @param locale string 语言名称，例如 ja-JP
+ * @param messages map[string]string 错误提示
+ */
+func RegisterLocale(locale string, messages map[string]string) {
+	copied := make(map[string]string, len(messages))
+	for key, msg := range messages {
+		copied[key] = msg
+	}
+	localeLock.Lock()
+	defer localeLock.Unlock()
+	localeMap[locale] = copied
+}
+
+/**
+ * 获取当前语言下的默认错误提示
+ *
+ * @param rule {string} 验证规则
+ * @param field {string} 需要验证的字段
+ * @param param {string} 验证规则参数
+ * @return string
+ */
+func (v *Validator) localeMessage(rule string, field string, param string) string {
+	tpl := ""
+	localeLock.RLock()
+	defer localeLock.RUnlock()
+	for _, name := range []string{v.locale, defaultLocale} {
+		messages, ok := localeMap[name]
+		if !ok {
+			continue
+		}
+		if msg, ok := messages[strings.ToLower(rule)]; ok {
+			tpl = msg
+		} else if msg, ok := messages["def"]; ok {
+			tpl = msg
+		} else {
+			continue
+		}
+		break
+	}
+
+	return strings.NewReplacer("{field}", field, "{rule}", rule, "{param}", param).Replace(tpl)
+}
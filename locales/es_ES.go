@@ -0,0 +1,52 @@
+package locales
+
+// Mensajes de error en español (es-ES)
+var EsES = map[string]string{
+	"def":            "El campo {field} no es válido",
+	"missing":        "El campo {field} es obligatorio",
+	"unknown":        "El campo {field} no está permitido",
+	"unknownrule":    "La regla de validación {param} del campo {field} no existe",
+	"throttle":       "Demasiados intentos para el campo {field}, inténtelo de nuevo más tarde",
+	"required":       "El campo {field} es obligatorio",
+	"min":            "El campo {field} debe tener al menos {param} caracteres",
+	"max":            "El campo {field} no debe superar los {param} caracteres",
+	"regex":          "El formato del campo {field} no es válido",
+	"int":            "El campo {field} debe ser un número entero",
+	"numeric":        "El campo {field} solo puede contener dígitos",
+	"email":          "El campo {field} debe ser una dirección de correo electrónico válida",
+	"url":            "El campo {field} debe ser una URL válida",
+	"mobile":         "El campo {field} debe ser un número de móvil válido",
+	"in":             "El campo {field} debe ser uno de los siguientes valores: {param}",
+	"lt":             "El campo {field} debe ser menor que {param}",
+	"lte":            "El campo {field} debe ser menor o igual que {param}",
+	"gt":             "El campo {field} debe ser mayor que {param}",
+	"gte":            "El campo {field} debe ser mayor o igual que {param}",
+	"odd":            "El campo {field} debe ser un número impar",
+	"even":           "El campo {field} debe ser un número par",
+	"hexstring":      "El campo {field} debe ser una cadena hexadecimal",
+	"creditcard":     "El campo {field} debe ser un número de tarjeta de crédito válido",
+	"iban":           "El campo {field} debe ser un IBAN válido",
+	"bic":            "El campo {field} debe ser un código BIC/SWIFT válido",
+	"ean":            "El campo {field} debe ser un código EAN válido",
+	"isbn":           "El campo {field} debe ser un ISBN válido",
+	"ssn":            "El campo {field} debe ser un número de la Seguridad Social de EE. UU. válido",
+	"htmlfree":       "El campo {field} no puede contener etiquetas HTML",
+	"nosqlinjection": "El campo {field} contiene caracteres no permitidos",
+	"strongentropy":  "El campo {field} es demasiado fácil de adivinar",
+	"commonpassword": "El campo {field} es una contraseña demasiado común",
+	"jwtformat":      "El campo {field} debe ser un JWT válido",
+	"xmlsafe":        "El campo {field} debe ser un XML válido",
+	"intersection":   "El campo {field} debe incluir al menos uno de los siguientes valores: {param}",
+	"superset":       "El campo {field} debe incluir todos los siguientes valores: {param}",
+	"ipinrange":      "El campo {field} debe ser una dirección IP dentro del rango {param}",
+	"latitude":       "El campo {field} debe ser una latitud válida",
+	"longitude":      "El campo {field} debe ser una longitud válida",
+	"atleastone":     "Debe completar al menos uno de los campos del grupo {param}",
+	"ltfield":        "El campo {field} debe ser menor que el campo {param}",
+	"ltefield":       "El campo {field} debe ser menor o igual que el campo {param}",
+	"gtfield":        "El campo {field} debe ser mayor que el campo {param}",
+	"gtefield":       "El campo {field} debe ser mayor o igual que el campo {param}",
+	"same":           "El campo {field} debe coincidir con el campo {param}",
+	"different":      "El campo {field} debe ser distinto del campo {param}",
+	"or":             "El campo {field} no es válido",
+}
@@ -0,0 +1,52 @@
+package locales
+
+// 日本語(ja-JP)エラーメッセージ
+var JaJP = map[string]string{
+	"def":            "{field}の形式が正しくありません",
+	"missing":        "{field}は必須です",
+	"unknown":        "{field}は想定外の項目です",
+	"unknownrule":    "{field}に指定された検証ルール{param}は存在しません",
+	"throttle":       "{field}の試行回数が多すぎます。しばらくしてから再度お試しください",
+	"required":       "{field}は必須です",
+	"min":            "{field}は{param}文字以上で入力してください",
+	"max":            "{field}は{param}文字以内で入力してください",
+	"regex":          "{field}の形式が正しくありません",
+	"int":            "{field}は整数で入力してください",
+	"numeric":        "{field}は数字のみで入力してください",
+	"email":          "{field}は有効なメールアドレスを入力してください",
+	"url":            "{field}は有効なURLを入力してください",
+	"mobile":         "{field}は有効な携帯電話番号を入力してください",
+	"in":             "{field}は{param}のいずれかを指定してください",
+	"lt":             "{field}は{param}より小さい値を入力してください",
+	"lte":            "{field}は{param}以下の値を入力してください",
+	"gt":             "{field}は{param}より大きい値を入力してください",
+	"gte":            "{field}は{param}以上の値を入力してください",
+	"odd":            "{field}は奇数で入力してください",
+	"even":           "{field}は偶数で入力してください",
+	"hexstring":      "{field}は16進数の文字列で入力してください",
+	"creditcard":     "{field}は有効なクレジットカード番号を入力してください",
+	"iban":           "{field}は有効なIBANを入力してください",
+	"bic":            "{field}は有効なBIC(SWIFTコード)を入力してください",
+	"ean":            "{field}は有効なEANコードを入力してください",
+	"isbn":           "{field}は有効なISBNを入力してください",
+	"ssn":            "{field}は有効な社会保障番号を入力してください",
+	"htmlfree":       "{field}にHTMLタグを含めることはできません",
+	"nosqlinjection": "{field}に使用できない文字列が含まれています",
+	"strongentropy":  "{field}は推測されにくい文字列にしてください",
+	"commonpassword": "{field}はよく使われるパスワードのため使用できません",
+	"jwtformat":      "{field}は有効なJWTではありません",
+	"xmlsafe":        "{field}は有効なXMLではありません",
+	"intersection":   "{field}には{param}のいずれかを含めてください",
+	"superset":       "{field}には{param}をすべて含めてください",
+	"ipinrange":      "{field}は{param}の範囲内のIPアドレスを入力してください",
+	"latitude":       "{field}は有効な緯度を入力してください",
+	"longitude":      "{field}は有効な経度を入力してください",
+	"atleastone":     "{param}のいずれか1つ以上を入力してください",
+	"ltfield":        "{field}は{param}より小さい値を入力してください",
+	"ltefield":       "{field}は{param}以下の値を入力してください",
+	"gtfield":        "{field}は{param}より大きい値を入力してください",
+	"gtefield":       "{field}は{param}以上の値を入力してください",
+	"same":           "{field}と{param}が一致しません",
+	"different":      "{field}と{param}には異なる値を入力してください",
+	"or":             "{field}の形式が正しくありません",
+}
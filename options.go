@@ -0,0 +1,189 @@
+package validator
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// 验证器配置项
+type Option func(*Validator)
+
+/**
+ * 设置自定义错误提示
+ *
+ * @param message map[string]string key为验证字段或"字段.规则"
+ * @return Option
+ */
+func WithMessages(message map[string]string) Option {
+	return func(v *Validator) {
+		v.message = message
+	}
+}
+
+/**
+ * 设置自定义错误码
+ *
+ * @param codes map[string]CustomCodeElem key为验证字段，value为验证规则对应的错误码
+ * @return Option
+ */
+func WithErrorCodes(codes map[string]CustomCodeElem) Option {
+	return func(v *Validator) {
+		v.customCode = codes
+	}
+}
+
+/**
+ * 设置上下文，上下文取消或超时后停止验证并返回上下文错误
+ *
+ * @param ctx context.Context
+ * @return Option
+ */
+func WithContext(ctx context.Context) Option {
+	return func(v *Validator) {
+		v.ctx = ctx
+	}
+}
+
+/**
+ * 验证前去除所有值的首尾空白字符，不会修改传入的原始数据
+ *
+ * @return Option
+ */
+func WithTrimAll() Option {
+	return func(v *Validator) {
+		v.trimAll = true
+	}
+}
+
+/**
+ * 遇到第一个验证错误后立即停止所有字段的验证
+ * 如果只需要停止单个字段的后续验证，可以在该字段规则中添加 bail
+ *
+ * @return Option
+ */
+func WithBail() Option {
+	return func(v *Validator) {
+		v.bail = true
+	}
+}
+
+/**
+ * 设置默认错误提示语言，未注册的语言使用英文提示
+ *
+ * @param locale string 语言名称，例如 en
+ * @return Option
+ */
+func WithLocale(locale string) Option {
+	return func(v *Validator) {
+		v.locale = locale
+	}
+}
+
+/**
+ * 设置日志，每执行一条验证规则输出一条Debug日志，包含 field、rule、passed 属性
+ *
+ * @param l *slog.Logger 为nil时不输出日志
+ * @return Option
+ */
+func WithLogger(l *slog.Logger) Option {
+	return func(v *Validator) {
+		v.logger = l
+	}
+}
+
+/**
+ * 设置最长验证时间，每个字段验证前检测是否超时
+ * 超时后停止验证，返回包装了 context.DeadlineExceeded 的错误，并以 timeout 为key记录未验证的字段
+ *
+ * @param d time.Duration
+ * @return Option
+ */
+func WithMaxRuntime(d time.Duration) Option {
+	return func(v *Validator) {
+		v.maxRuntime = d
+	}
+}
+
+/**
+ * 验证完成后将未配置验证规则的字段以 unknown 为key添加到 Warnings 中，不影响验证结果
+ *
+ * @return Option
+ */
+func WithReportUnknownFields() Option {
+	return func(v *Validator) {
+		v.reportUnknown = true
+	}
+}
+
+/**
+ * 设置验证规则不存在时的处理方式
+ * recover 为true时记录验证错误并继续验证，为false时panic
+ * 默认为true，使用 validator_debug 构建标签编译时默认为false
+ *
+ * @param recover bool
+ * @return Option
+ */
+func WithStrictRuleNames(recover bool) Option {
+	return func(v *Validator) {
+		v.recoverRule = recover
+	}
+}
+
+/**
+ * 仅验证指定字段，用于分步表单等场景
+ * 未指定但包含 required 规则的字段仍会检测是否缺失
+ *
+ * @param fields ...string 验证字段
+ * @return Option
+ */
+func WithFields(fields ...string) Option {
+	return func(v *Validator) {
+		v.fields = append([]string{}, fields...)
+	}
+}
+
+/**
+ * 开启幂等检测，记录验证数据与规则的MD5摘要，ReRun 时摘要未变化则直接返回上次的验证结果
+ * 包含非幂等规则(通过 SetRuleIdempotent 设置)时不使用缓存
+ *
+ * @return Option
+ */
+func WithIdempotencyCheck() Option {
+	return func(v *Validator) {
+		v.idempotencyCheck = true
+	}
+}
+
+/**
+ * 复制验证数据并去除首尾空白字符
+ *
+ * @param data map[string][]string
+ * @return map[string][]string
+ */
+func trimData(data map[string][]string) map[string][]string {
+	trimmed := make(map[string][]string, len(data))
+	for key, item := range data {
+		values := make([]string, len(item))
+		for i, val := range item {
+			values[i] = strings.TrimSpace(val)
+		}
+		trimmed[key] = values
+	}
+	return trimmed
+}
+
+/**
+ * 复制验证数据，转换类验证器只会替换字段的值，因此不需要复制每一项
+ *
+ * @param data map[string][]string
+ * @return map[string][]string
+ */
+func copyData(data map[string][]string) map[string][]string {
+	copied := make(map[string][]string, len(data))
+	for key, item := range data {
+		copied[key] = item
+	}
+	return copied
+}
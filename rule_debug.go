@@ -0,0 +1,6 @@
+//go:build validator_debug
+
+package validator
+
+// 开发环境验证规则不存在时默认panic，便于及时发现错误
+const defaultRecoverRule = false
@@ -0,0 +1,6 @@
+//go:build !validator_debug
+
+package validator
+
+// 验证规则不存在时默认记录验证错误，避免线上环境panic
+const defaultRecoverRule = true
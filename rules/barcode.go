@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"strings"
+)
+
+/**
+ * 验证EAN商品条码，包括校验位
+ *
+ * @param value 需要验证的值
+ * @param param 可选，条码位数 8 或 13，为空时两者均可
+ * @return bool
+ */
+func EAN(value []string, param string) bool {
+	if len(value) <= 0 {
+		return false
+	}
+	code := value[0]
+	if param == "8" && len(code) != 8 || param == "13" && len(code) != 13 {
+		return false
+	}
+	if len(code) != 8 && len(code) != 13 {
+		return false
+	}
+	return eanChecksum(code)
+}
+
+/**
+ * 验证ISBN书号，包括校验位，允许包含连字符和空格
+ *
+ * @param value 需要验证的值
+ * @param param 可选，书号位数 10 或 13，为空时两者均可
+ * @return bool
+ */
+func ISBN(value []string, param string) bool {
+	if len(value) <= 0 {
+		return false
+	}
+	code := strings.NewReplacer("-", "", " ", "").Replace(value[0])
+	if param == "10" && len(code) != 10 || param == "13" && len(code) != 13 {
+		return false
+	}
+
+	switch len(code) {
+	case 10:
+		return isbn10Checksum(code)
+	case 13:
+		if !strings.HasPrefix(code, "978") && !strings.HasPrefix(code, "979") {
+			return false
+		}
+		return eanChecksum(code)
+	default:
+		return false
+	}
+}
+
+// EAN/ISBN-13 校验: 从右往左权重依次为1、3交替，加权和能被10整除
+func eanChecksum(code string) bool {
+	sum := 0
+	for i := len(code) - 1; i >= 0; i-- {
+		c := code[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+		weight := 1
+		if (len(code)-1-i)%2 == 1 {
+			weight = 3
+		}
+		sum += int(c-'0') * weight
+	}
+	return sum%10 == 0
+}
+
+// ISBN-10 校验: 权重从10递减至1，末位可为X(代表10)，加权和能被11整除
+func isbn10Checksum(code string) bool {
+	sum := 0
+	for i := 0; i < 10; i++ {
+		c := code[i]
+		digit := 0
+		switch {
+		case c >= '0' && c <= '9':
+			digit = int(c - '0')
+		case i == 9 && (c == 'X' || c == 'x'):
+			digit = 10
+		default:
+			return false
+		}
+		sum += digit * (10 - i)
+	}
+	return sum%11 == 0
+}
@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"strconv"
+	"time"
+)
+
+// 跨字段比较支持的日期格式
+var compareDateLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"}
+
+/**
+ * 验证当前字段小于指定字段
+ *
+ * @param value 需要验证的值
+ * @param field 比较字段名称
+ * @param data 全部验证数据
+ * @return bool
+ */
+func LtField(value []string, field string, data map[string][]string) bool {
+	return compareField(value, field, data, "<")
+}
+
+func LteField(value []string, field string, data map[string][]string) bool {
+	return compareField(value, field, data, "<=")
+}
+
+func GtField(value []string, field string, data map[string][]string) bool {
+	return compareField(value, field, data, ">")
+}
+
+func GteField(value []string, field string, data map[string][]string) bool {
+	return compareField(value, field, data, ">=")
+}
+
+/**
+ * 比较当前字段与指定字段的值，两者均为数字时按数值比较，均为日期时按时间比较
+ *
+ * @param value 需要验证的值
+ * @param field 比较字段名称
+ * @param data 全部验证数据
+ * @param symbol 比较符号
+ * @return bool
+ */
+func compareField(value []string, field string, data map[string][]string, symbol string) bool {
+	other, ok := data[field]
+	if !ok || len(value) <= 0 || len(other) <= 0 {
+		return false
+	}
+
+	var diff float64
+	current, err1 := strconv.ParseFloat(value[0], 64)
+	target, err2 := strconv.ParseFloat(other[0], 64)
+	if err1 == nil && err2 == nil {
+		diff = current - target
+	} else {
+		currentTime, ok1 := parseCompareDate(value[0])
+		targetTime, ok2 := parseCompareDate(other[0])
+		if !ok1 || !ok2 {
+			return false
+		}
+		diff = float64(currentTime.Sub(targetTime))
+	}
+
+	switch symbol {
+	case "<":
+		return diff < 0
+	case "<=":
+		return diff <= 0
+	case ">":
+		return diff > 0
+	case ">=":
+		return diff >= 0
+	default:
+		return false
+	}
+}
+
+func parseCompareDate(value string) (time.Time, bool) {
+	for _, layout := range compareDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+/**
+ * 验证当前字段与指定字段的值相同
+ *
+ * @param value 需要验证的值
+ * @param field 比较字段名称
+ * @param data 全部验证数据
+ * @return bool
+ */
+func Same(value []string, field string, data map[string][]string) bool {
+	other, ok := data[field]
+	if !ok || len(value) != len(other) {
+		return false
+	}
+	for i := range value {
+		if value[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+/**
+ * 验证当前字段与指定字段的值不同，指定字段不存在时视为不同
+ *
+ * @param value 需要验证的值
+ * @param field 比较字段名称
+ * @param data 全部验证数据
+ * @return bool
+ */
+func Different(value []string, field string, data map[string][]string) bool {
+	if _, ok := data[field]; !ok {
+		return true
+	}
+	return !Same(value, field, data)
+}
@@ -0,0 +1,217 @@
+package rules
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// 信用卡网络号段及卡号长度
+type cardNetwork struct {
+	prefixes [][2]int // 前缀区间，包含边界
+	lengths  []int    // 允许的卡号长度
+}
+
+var cardNetworks = map[string]cardNetwork{
+	"visa": {
+		prefixes: [][2]int{{4, 4}},
+		lengths:  []int{13, 16, 19},
+	},
+	"mastercard": {
+		prefixes: [][2]int{{51, 55}, {2221, 2720}},
+		lengths:  []int{16},
+	},
+	"amex": {
+		prefixes: [][2]int{{34, 34}, {37, 37}},
+		lengths:  []int{15},
+	},
+	"discover": {
+		prefixes: [][2]int{{6011, 6011}, {644, 649}, {65, 65}, {622126, 622925}},
+		lengths:  []int{16, 17, 18, 19},
+	},
+}
+
+/**
+ * 验证信用卡卡号，包括卡组织号段、长度以及Luhn校验
+ *
+ * @param value 需要验证的值
+ * @param param 可选，允许的卡组织，多个用逗号分隔，例如 visa,mastercard
+ * @return bool
+ */
+func CreditCard(value []string, param string) bool {
+	if len(value) <= 0 {
+		return false
+	}
+	number := strings.NewReplacer(" ", "", "-", "").Replace(value[0])
+	if len(number) <= 0 || !luhn(number) {
+		return false
+	}
+
+	networks := make([]string, 0, len(cardNetworks))
+	if len(param) > 0 {
+		networks = strings.Split(param, ",")
+	} else {
+		for name := range cardNetworks {
+			networks = append(networks, name)
+		}
+	}
+
+	for _, name := range networks {
+		network, ok := cardNetworks[strings.ToLower(strings.TrimSpace(name))]
+		if ok && network.match(number) {
+			return true
+		}
+	}
+	return false
+}
+
+func (n cardNetwork) match(number string) bool {
+	lengthOk := false
+	for _, length := range n.lengths {
+		if len(number) == length {
+			lengthOk = true
+			break
+		}
+	}
+	if !lengthOk {
+		return false
+	}
+
+	for _, prefix := range n.prefixes {
+		digits := len(strconv.Itoa(prefix[0]))
+		head, err := strconv.Atoi(number[:digits])
+		if err != nil {
+			return false
+		}
+		if head >= prefix[0] && head <= prefix[1] {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Luhn 校验算法
+ *
+ * @param number 纯数字字符串
+ * @return bool
+ */
+func luhn(number string) bool {
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		c := rune(number[i])
+		if !unicode.IsDigit(c) {
+			return false
+		}
+		digit := int(c - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// IBAN 各国家/地区账号长度
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16,
+	"BG": 22, "BH": 22, "BR": 29, "CH": 21, "CR": 22, "CY": 28, "CZ": 24,
+	"DE": 22, "DK": 18, "DO": 28, "EE": 20, "ES": 24, "FI": 18, "FO": 18,
+	"FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27, "GT": 28,
+	"HR": 21, "HU": 28, "IE": 22, "IL": 23, "IS": 26, "IT": 27, "JO": 30,
+	"KW": 30, "KZ": 20, "LB": 28, "LI": 21, "LT": 20, "LU": 20, "LV": 21,
+	"MC": 27, "MD": 24, "ME": 22, "MK": 19, "MR": 27, "MT": 31, "MU": 30,
+	"NL": 18, "NO": 15, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29,
+	"RO": 24, "RS": 22, "SA": 24, "SE": 24, "SI": 19, "SK": 24, "SM": 27,
+	"TN": 24, "TR": 26, "UA": 29, "VG": 24, "XK": 20,
+}
+
+/**
+ * 验证国际银行账号(IBAN)，包括国家代码、长度以及mod-97校验
+ *
+ * @param value 需要验证的值
+ * @param param 可选，允许的国家代码，多个用逗号分隔，例如 DE,FR
+ * @return bool
+ */
+func IBAN(value []string, param string) bool {
+	if len(value) <= 0 {
+		return false
+	}
+	iban := strings.ToUpper(strings.Replace(value[0], " ", "", -1))
+	if len(iban) < 4 {
+		return false
+	}
+
+	country := iban[:2]
+	length, ok := ibanLengths[country]
+	if !ok || len(iban) != length {
+		return false
+	}
+
+	if len(param) > 0 {
+		allowed := false
+		for _, item := range strings.Split(param, ",") {
+			if strings.ToUpper(strings.TrimSpace(item)) == country {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+
+	// 将前4位移至末尾，字母转换为数字(A=10...Z=35)后逐位取模
+	remainder := 0
+	for _, c := range iban[4:] + iban[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return false
+		}
+	}
+	return remainder == 1
+}
+
+// BIC格式: 4位银行代码 + 2位国家代码 + 2位地区代码 + 可选3位分行代码
+var bicPattern = regexp.MustCompile("^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$")
+
+/**
+ * 验证SWIFT/BIC代码
+ *
+ * @param value 需要验证的值
+ * @param param 可选，strict 严格模式：必须为大写，不接受测试代码(地区代码第二位为0)
+ *              以及以X开头但不为XXX的分行代码
+ * @return bool
+ */
+func BIC(value []string, param string) bool {
+	if len(value) <= 0 {
+		return false
+	}
+	code := value[0]
+	if param != "strict" {
+		code = strings.ToUpper(code)
+	}
+	if !bicPattern.MatchString(code) {
+		return false
+	}
+
+	if param == "strict" {
+		if code[7] == '0' {
+			return false
+		}
+		if len(code) == 11 && code[8] == 'X' && code[8:] != "XXX" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+//go:build ignore
+
+// 根据 common_passwords.txt 生成 passwords.go，每行一个明文密码，空行及 # 开头的行会被忽略
+//
+//	go generate ./rules
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+func main() {
+	file, err := os.Open("common_passwords.txt")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	buckets := make(map[string][]string)
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || seen[line] {
+			continue
+		}
+		seen[line] = true
+		sum := sha1.Sum([]byte(line))
+		hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+		buckets[hash[:5]] = append(buckets[hash[:5]], hash[5:])
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	prefixes := make([]string, 0, len(buckets))
+	for prefix := range buckets {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_passwords.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package rules\n\n")
+	fmt.Fprintf(&buf, "// 常见弱密码的SHA-1摘要，共%d个密码，由 common_passwords.txt 生成，按HIBP k-anonymity格式组织：\n", len(seen))
+	buf.WriteString("// key为摘要(大写十六进制)的前5位，value为剩余35位后缀。\n")
+	buf.WriteString("var commonPasswordHashes = map[string][]string{\n")
+	for _, prefix := range prefixes {
+		suffixes := buckets[prefix]
+		sort.Strings(suffixes)
+		fmt.Fprintf(&buf, "\t%q: {%q", prefix, suffixes[0])
+		for _, suffix := range suffixes[1:] {
+			fmt.Fprintf(&buf, ", %q", suffix)
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("passwords.go", source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
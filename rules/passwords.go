@@ -0,0 +1,244 @@
+// Code generated by gen_passwords.go; DO NOT EDIT.
+
+package rules
+
+// 常见弱密码的SHA-1摘要，共236个密码，由 common_passwords.txt 生成，按HIBP k-anonymity格式组织：
+// key为摘要(大写十六进制)的前5位，value为剩余35位后缀。
+var commonPasswordHashes = map[string][]string{
+	"00683": {"9D264A38B7F58E5C8130447528BF4B7AEE1"},
+	"011C9": {"45F30CE2CBAFC452F39840F025693339C42"},
+	"019DB": {"0BFD5F85951CB46E4452E9642858C004155"},
+	"01B30": {"7ACBA4F54F55AAFC33BB06BBBF6CA803E9A"},
+	"02E0A": {"999C50B1F88DF7A8F5A04E1B76B35EA6A88"},
+	"03FDF": {"1323C8D4770C90576CE2A1860D476DED8AB"},
+	"043A5": {"58250409758B64F73D07D7F06B3DF654BC0"},
+	"05B53": {"0AD0FB56286FE051D5F8BE5B8453F1CD93F"},
+	"05FE7": {"461C607C33229772D402505601016A7D0EA"},
+	"08B31": {"4F0E1E2C41EC92C3735910658E5A82C6BA7"},
+	"0922B": {"57BAA034D90D4752E5DE9C501709AADE466"},
+	"0D0CB": {"B59296D9ACC111F9D04BAC586C827724CF1"},
+	"0E32F": {"FD628B5F4716F7EC29E13BF98FDD0462AE4"},
+	"0F125": {"41AFCCE175FB34BB05A79C95B76E765488B"},
+	"0FECA": {"720E2C29DAFB2C900713BA560E03B758711"},
+	"11594": {"787A658A5DE6A49DCCFB90C889FAD9EEEF1"},
+	"12E92": {"93EC6B30C7FA8A0926AF42807E929C1684F"},
+	"14116": {"78A0B9E25EE2F7C8B2F7AC92B6A74B3F9C5"},
+	"1496A": {"A696D9D35AA2C23B0F1EF3020DF7F26F869"},
+	"17B9E": {"1C64588C7FA6419B4D29DC1F4426279BA01"},
+	"18C28": {"604DD31094A8D69DAE60F1BCD347F1AFC5A"},
+	"18F3E": {"922A1D1A9A140EFBBE894BC829EEEC260D8"},
+	"19485": {"E369C691FA8ECE1FABC8A6CEABFB5666B79"},
+	"1999E": {"4893F732BA38B948DBE8D34ED48CD54F058"},
+	"1CB5B": {"D5A9E45420321F44C72DA5D90D7F0432FFB"},
+	"1D5B1": {"80702E9C654DE02033ADF2763F9E6D79C66"},
+	"1F552": {"3A8F535289B3401B29958D01B2966ED61D2"},
+	"1F82C": {"942BEFDA29B6ED487A51DA199F78FCE7F05"},
+	"1F8AC": {"10F23C5B5BC1167BDA84B833E5C057A77D2"},
+	"1FC85": {"4110E5532480000542834F453DE31936C2F"},
+	"20EAB": {"E5D64B0E216796E834F52D61FD0B70332FC"},
+	"226C0": {"96E795854EB48BD226B9CDE2F7BAE2BA106"},
+	"23869": {"B733FCD6665832F65258AC650E6EC89A4A7"},
+	"2394E": {"EAC9FC3DB56189A894E221220B6089E78D3"},
+	"23F29": {"16E01209D6282F226BE9677AFFAEC44A8D6"},
+	"2891B": {"ACEEEF1652EE698294DA0E71BA78A2A4064"},
+	"2C4C3": {"891E2AC6958E9810A1E49C6705784FBFA1A"},
+	"2D27B": {"62C597EC858F6E7B54E7E58525E6A95E6D8"},
+	"2EA62": {"01A068C5FA0EEA5D81A3863321A87F8D533"},
+	"2F2BB": {"917A7B0317ED404511AFA79514A2133DFD8"},
+	"2FB5E": {"13419FC89246865E7A324F476EC624E8740"},
+	"313AF": {"A5189C150B7B0F3E6D39E0FA223F88EC42B"},
+	"32715": {"6AB287C6AA52C8670E13163FC1BF660ADD4"},
+	"32CA9": {"FC1A0F5B6330E3F4C8C1BBECDE9BEDB9573"},
+	"34512": {"0426285FF8B1D43653A4D078170B4761F75"},
+	"35675": {"E68F4B5AF7B995D9205AD0FC43842F16450"},
+	"360E4": {"6F15F432AF83C77017177A759ABA8A58519"},
+	"36E61": {"8512A68721F032470BB0891ADEF3362CFA9"},
+	"39693": {"FD4A45B386C28C63100CC930238259891A2"},
+	"3ACD0": {"BE86DE7DCCCDBF91B20F94A68CEA535922D"},
+	"3D0F3": {"B9DDCACEC30C4008C5E030E6C13A478CB4F"},
+	"3D4F2": {"BF07DC1BE38B20CD6E46949A1071F9D0E3D"},
+	"3FB37": {"2A9023613ACE074B4E66ECC4360A00F03B4"},
+	"3FCFC": {"1F7F34E78A937E81171BA51DC39538DB993"},
+	"40123": {"E9C6273385EA69892C48C80AA6CB25B9113"},
+	"40392": {"6033D001B5279DF37CBBE5287B7C7C267FA"},
+	"40D35": {"D55F267E36711ECB6DCA59DF4036A1DD556"},
+	"42331": {"37D1C510F2E55BA5CB220B864B11033F156"},
+	"435B4": {"1068E8665513A20070C033B08B9C66E4332"},
+	"46DCD": {"4DD65B63D106B8CFB4AAD906B23716CC613"},
+	"475A7": {"4E3C0C82094CAE9BDC8E0DD34FFC78770FB"},
+	"48058": {"E0C99BF7D689CE71C360699A14CE2F99774"},
+	"48EFC": {"4851E15940AF5D477D3C0CE99211A70A3BE"},
+	"49EFE": {"F5F70D47ADC2DB2EB397FBEF5F7BC560E29"},
+	"49F25": {"741FF0DB65A7C4290AA73F34B4D4A3644C6"},
+	"4BE30": {"D9814C6D4E9800E0D2EA9EC9FB00EFA887B"},
+	"4BFE0": {"29D971DDB359DABED0D0AB968A329ED0AB0"},
+	"4D0FB": {"475B242228032CBDF6D53924D2538DF037B"},
+	"4D901": {"2B4A77A9524D675DAD27C3276AB5705E5E8"},
+	"4F26A": {"EAFDB2367620A393C973EDDBE8F8B846EBD"},
+	"51C47": {"6F0BCAF6BBB300A2632EC50B66FB012E9B6"},
+	"53E11": {"EB7B24CC39E33733A0FF06640F1B39425EA"},
+	"57B2A": {"D99044D337197C0C39FD3823568FF81E48A"},
+	"59033": {"478180D07080D5E4F3BAA0099996C364162"},
+	"59C82": {"6FC854197CBD4D1083BCE8FC00D0761E8B3"},
+	"5A46B": {"8253D07320A14CACE9B4DCBF80F93DCEF04"},
+	"5BAA6": {"1E4C9B93F3F0682250B6CF8331B7EE68FD8"},
+	"5C17F": {"A03E6D5FC247565E1CD8FFA70E1BFE5B8D9"},
+	"5C6AC": {"A6504E010FC38BDBF9B940CAA1D463407CF"},
+	"5C6D9": {"EDC3A951CDA763F650235CFC41A3FC23FE8"},
+	"5CEC1": {"75B165E3D5E62C9E13CE848EF6FEAC81BFF"},
+	"5D74A": {"E093A16A00E5AF127763F2DC7E13988F162"},
+	"5F50A": {"84C1FA3BCFF146405017F36AEC1A10A9E38"},
+	"5FA33": {"9BBBB1EEACED3B52E54F44576AAF0D77D96"},
+	"5FEE0": {"0239940F883D4C2854E41C7F989E75278A3"},
+	"601F1": {"889667EFAEBB33B8C12572835DA3F027F78"},
+	"6367C": {"48DD193D56EA7B0BAAD25B19455E529F5EE"},
+	"6420E": {"D4D831B436D1E92D25605D18297296374E3"},
+	"64356": {"BCFAE350C970263C1CE575185B289F7B836"},
+	"65B3D": {"D225FE19C6A9EC4383161EA00FE0F161157"},
+	"6C616": {"F7C2D2FDE9018A09F06EAEFCFC7582BC7BA"},
+	"6CF34": {"755B9DE3322045869F47DC449B4785B8226"},
+	"6E2F9": {"E6111E77EDD0C446EA7A84E25323D137A61"},
+	"701B3": {"89B848A2B1CFAB867093101D8D5AC56ADDD"},
+	"70CCD": {"9007338D6D81DD3B6271621B9CF9A97EA00"},
+	"7110E": {"DA4D09E062AA5E4A390B0A572AC0D2C0220"},
+	"71486": {"86369B144C8E4147A0C9BA3E45FECEFD6B3"},
+	"7212A": {"9E01329EA93A57F574BD9BF77695D5FDCA4"},
+	"7288E": {"DD0FC3FFCBE93A0CF06E3568E28521687BC"},
+	"74A87": {"1ACBF060DDA5FC7260D05A5924A34E4C0E7"},
+	"75973": {"0A97E4373F3A0EE12805DB065E3A4A649A5"},
+	"77282": {"40C80B6BFD450849405E8500D6D207783B6"},
+	"775BB": {"961B81DA1CA49217A48E533C832C337154A"},
+	"782F9": {"B10621E362D5BD0DEF3A279B5E0908C9EBB"},
+	"79700": {"9CA0DDC4EDE177EED0558234C5FE2C08376"},
+	"7AB51": {"5D12BD2CF431745511AC4EE13FED15AB578"},
+	"7B218": {"48AC9AF35BE0DDB2D6B9FC3851934DB8420"},
+	"7BD3F": {"297BBFD4359FF740509B2EA2B1CA733EB35"},
+	"7C222": {"FB2927D828AF22F592134E8932480637C0D"},
+	"7C4A8": {"D09CA3762AF61E59520943DC26494F8941B"},
+	"7C6A6": {"1C68EF8B9B6B061B28C348BC1ED7921CB53"},
+	"7CE03": {"59F12857F2A90C7DE465F40A95F01CB5DA9"},
+	"7EA35": {"D812706D9213868749011AF1ED4FA2F6AA0"},
+	"7ECFD": {"8F97B4729C6FF0799B0B4D40F870083B461"},
+	"81941": {"ADD3E463581722BAC84D02282CAFB1C32C2"},
+	"83769": {"22A27E83B9EADCDEC3596A70BF6C4DB5730"},
+	"83DD9": {"D6AF43F8CBEE08ACD981417321E144B776B"},
+	"83E8C": {"EF8D84F02139290F90F29C0338EE7B4C246"},
+	"88FDD": {"585121A4CCB3D1540527AEE53A77C77ABB8"},
+	"895B3": {"17C76B8E504C2FB32DBB4420178F60CE321"},
+	"89C6B": {"5C0F1F0EB8DB8B274A9297A3D440CE0D8C7"},
+	"89E89": {"C17F877CA2821B557F633CEC3253B0AA941"},
+	"8A162": {"1DAE39BF1D91D372C77F441E80B8F68B9B6"},
+	"8BC5D": {"E83CF1DAF79ED5B2F13F93D7C05D01D0388"},
+	"8C258": {"085654083B891CB5125CB6DCB740C8A73F8"},
+	"8CB22": {"37D0679CA88DB6464EAC60DA96345513964"},
+	"8D500": {"4C9C74259AB775F63F7131DA077814A7636"},
+	"8D6E3": {"4F987851AA599257D3831A1AF040886842F"},
+	"92119": {"E2C63E9366ACFEFE818B50537A85577E2DB"},
+	"9233C": {"CB325766AF9FA5F4C2400E006F857D785D6"},
+	"93EC7": {"1B22793A81569C94CA17E4D9C293D8E201F"},
+	"94CD1": {"66631D14DAB533858B9B47E9584A2FF3F65"},
+	"97968": {"09F7DAE482D3123C16585F2B60F97407796"},
+	"97BBC": {"79679FE1CFD9AFB52FD6F01D033B479555D"},
+	"99996": {"B911567C83CCE17CDF194F314975C57DDF1"},
+	"99EFC": {"50A9206BDE3D7A8E694AAD8E138CA7DC3F7"},
+	"9AC20": {"922B054316BE23842A5BCA7D69F29F69D77"},
+	"9B8C0": {"2FED3901E82728D18F32BB0369743B22C35"},
+	"9D4E1": {"E23BD5B727046A9E3B4B7DB57BD8D6EE684"},
+	"9F2FE": {"B0F1EF425B292F2F94BC8482494DF430413"},
+	"9FD8D": {"E5FC2A7C2C0D469B2FFF1AFDE4E5DEF37BA"},
+	"A1037": {"F14CEBC6BD318916F54CBE00D3EA2A197C1"},
+	"A1883": {"54F1BD5D49E4B97360DB2384B5B71B79D97"},
+	"A2C90": {"1C8C6DEA98958C219F6F2D038C44DC5D362"},
+	"A4AC9": {"14C09D7C097FE1F4F96B897E625B6922069"},
+	"A642A": {"77ABD7D4F51BF9226CEAF891FCBB5B299B8"},
+	"A6F37": {"5A196CD4C89C41DBB4500553EBF3BAB0A41"},
+	"A94A8": {"FE5CCB19BA61C4C0873D391E987982FBBD3"},
+	"A98D1": {"14C5520559433B9D409E6E60EEDF8B278A9"},
+	"AAF4C": {"61DDCC5E8A2DABEDE0F3B482CD9AEA9434D"},
+	"AB4FC": {"F2F1698FD1BC41701FBDDF12592891D0828"},
+	"AB87D": {"24BDC7452E55738DEB5F868E1F16DEA5ACE"},
+	"AC137": {"C6AE0947718332991E7CB2F50EB20B62AAA"},
+	"AD70A": {"B97AE1376E656002641CFB067C9C94906A2"},
+	"AEBC3": {"EBEE2F0C8B08B43D26C2B0055B19CAEAF4A"},
+	"AF897": {"8B1797B72ACFFF9595A5A2A373EC3D9106D"},
+	"B01AF": {"C2B077956ACC69F99E0B7DF1CB70CB01331"},
+	"B0399": {"D2029F64D445BD131FFAA399A42D2F8E7DC"},
+	"B03B7": {"4363BBB6EE42CE248C7A5344E92FFE76CC7"},
+	"B1B37": {"73A05C0ED0176787A4F1574FF0075F7521E"},
+	"B1F45": {"ED147D6803AC1A2A91BDEA1FAB603F910A5"},
+	"B2E98": {"AD6F6EB8508DD6A14CFA704BAD7F05F6FB1"},
+	"B2EE6": {"0370AD57D9BC3877E9024C507AB99303A64"},
+	"B3ACA": {"92C793EE0E9B1A9B0A5F5FC044E05140DF3"},
+	"B7A87": {"5FC1EA228B9061041B7CEC4BD3C52AB3CE3"},
+	"B7C40": {"B9C66BC88D38A59E554C639D743E77F1B65"},
+	"B80A9": {"AED8AF17118E51D4D0C2D7872AE26E2109E"},
+	"B99E0": {"D26BD5E00B07BE2517C1A966355E73E1A72"},
+	"BADCF": {"A3C62742B3BCC1DCD893E78713BD36AA430"},
+	"BCEF7": {"A046258082993759BADE995B3AE8BEE26C7"},
+	"BF2F7": {"49E80C970F50552E9D5F3E8434E78B88D35"},
+	"BFE54": {"CAA6D483CC3887DCE9D1B8EB91408F1EA7A"},
+	"C0B13": {"7FE2D792459F26FF763CCE44574A5B5AB03"},
+	"C1AB9": {"924ECDA1BEAF8BBAA1EB8238B83E0ED8C63"},
+	"C5325": {"5317BB11707D0F614696B3CE6F221D0E2F2"},
+	"C6026": {"6A8ADAD2F8EE67D793B4FD3FD0FFD73CC61"},
+	"C6922": {"B6BA9E0939583F973BC1682493351AD4FE8"},
+	"C739A": {"C81FDC698C3C62C6874C8CFF83E25A725BE"},
+	"C8A50": {"F632C3C4BAF27FC05FACB1883104E1D16EF"},
+	"C9525": {"9DE1FD719814DAEF8F1DC4BD64F9D885FF0"},
+	"C984A": {"ED014AEC7623A54F0591DA07A85FD4B762D"},
+	"CB047": {"D26CECB70DE3B7E682FA5E9D6C5539F7603"},
+	"CB45C": {"671CBC500627EA424EEA5F91996221B5935"},
+	"CBE64": {"8909034C0624C205FE219D3FBD10052C715"},
+	"CBE86": {"9668B9F87F1E14514260D97E7BEE2692C52"},
+	"CBFDA": {"C6008F9CAB4083784CBD1874F76618D2A97"},
+	"CCDEB": {"3789AA4A84316FCF8AC51977126BEF8DE35"},
+	"CD58D": {"4B62F9D31B3C6C52737CF5323CA6251C0FB"},
+	"CDF54": {"7ED4C64E6994AF35CFCD69C4204C9227A97"},
+	"CEDF4": {"1FCCB586DC39E1CE34BB482F0AFE557B49F"},
+	"D033E": {"22AE348AEB5660FC2140AEC35850C4DA997"},
+	"D04C1": {"675B232C6ECE69ED95E189E95D589F217B0"},
+	"D052F": {"85FA58FB0497AD4BB7F2D069DD486C4A9AA"},
+	"D0BE2": {"DC421BE4FCD0172E5AFCEEA3970E2F3D940"},
+	"D27F4": {"469BE6EADFDE078A1E371C9D67D3F7512C7"},
+	"D5A1B": {"DF9CE989FD6161063E94B92BDEACB94ED23"},
+	"D637E": {"6EDAF4193FFCD807B5F60282A26FF72989B"},
+	"D6955": {"D9721560531274CB8F50FF595A9BD39D66F"},
+	"D869D": {"B7FE62FB07C25A0403ECAEA55031744B5FB"},
+	"D8CD1": {"0B920DCBDB5163CA0185E402357BC27C265"},
+	"DB25F": {"2FC14CD2D2B1E7AF307241F548FB03C312A"},
+	"DC724": {"AF18FBDD4E59189F5FE768A5F8311527050"},
+	"DC76E": {"9F0C0006E8F919E0C515C66DBBA3982F785"},
+	"DD08B": {"58E1D30DAD48D37A35A8760CFFE8D756CFA"},
+	"DD2ED": {"B87EA9EB7A32FD4057276D3A1FAB861C1D5"},
+	"DD5FE": {"F9C1C1DA1394D6D34B248C51BE2AD740840"},
+	"DE346": {"0832EA070EFFABBC7032D7594BBDE1BB120"},
+	"DEA74": {"2E166979027AE70B28E0A9006FB1010E760"},
+	"DF70F": {"9B975B42116EE6C0231A7E6EAD0BBB283AA"},
+	"E0C95": {"748A455C27A80FD289269120D4944D1F318"},
+	"E35BE": {"CE6C5E6E0E86CA51D0440E92282A9D6AC8A"},
+	"E38AD": {"214943DAAD1D64C102FAEC29DE4AFE9DA3D"},
+	"E3CD9": {"F6469FC3E1ACFB9F2BDBFC5A3D2BBB8E2AD"},
+	"E5E02": {"13249CD5BD8FB9D09BB50854072D3DFA7DB"},
+	"E5E9F": {"A1BA31ECD1AE84F75CAAA474F3A663F05F4"},
+	"E6852": {"777C0260493DE41FB43918AB07BBB3A659C"},
+	"E68E1": {"1BE8B70E435C65AEF8BA9798FF7775C361E"},
+	"E8126": {"C64C3486E84081FFFAD6A0AB22D4267BB41"},
+	"ED9D3": {"D832AF899035363A69FD53CD3BE8F71501C"},
+	"EE8D8": {"728F435FD550F83852AABAB5234CE1DA528"},
+	"F08A7": {"A19E6F47E1125C9AEE2336C6759C7798FE4"},
+	"F2847": {"B1BD9624F927E979C1846D9FE17DD65F518"},
+	"F2B14": {"F68EB995FACB3A1C35287B778D5BD785511"},
+	"F3215": {"7A45887E4FE5ADC0B5198F7EC4920A526D7"},
+	"F4234": {"3E88594581338AA32DDA7A2AB368DD10EE4"},
+	"F4EE7": {"415066B23ED0C5555E3A10AA76726A995D7"},
+	"F71B4": {"7E5F8BE4C6E31DAD9F5BB646B0D544B5A90"},
+	"F7A9E": {"24777EC23212C54D7A350BC5BEA5477FDBB"},
+	"F7C3B": {"C1D808E04732ADF679965CCC34CA7AE3441"},
+	"F80D0": {"CA101E967B50B730DDF8E8ACA0DE85E8DF6"},
+	"F865B": {"53623B121FD34EE5426C792E5C33AF8C227"},
+	"FA9BE": {"B99E4029AD5A6615399E7BBAE21356086B3"},
+	"FAC67": {"3092FBDCAB2CD92EFC19675F2750ED97CA1"},
+	"FBA9F": {"1C9AE2A8AFE7815C9CDD492512622A66302"},
+	"FC84A": {"AA687374AED41957693F32664E5F4981862"},
+	"FDB87": {"DFD199045AF7165780B11640B83768A0D57"},
+}
@@ -1,6 +1,14 @@
 package rules
 
 import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"math"
+	"net"
 	"net/url"
 	"regexp"
 	"strconv"
@@ -161,6 +169,37 @@ func Nullable(_ []string, _ string) bool {
 	return true
 }
 
+// 去除首尾空白字符的转换类验证器，除验证外还会通过 Transform 修改验证数据
+type trimRule func(value []string, param string) bool
+
+/**
+ * 去除首尾空白字符，该规则总是验证通过，后续规则使用去除空白后的值
+ */
+var Trim = trimRule(func(_ []string, _ string) bool {
+	return true
+})
+
+/**
+ * 转换验证数据，去除每一项的首尾空白字符
+ *
+ * @param value 需要转换的值
+ * @return []string
+ */
+func (trimRule) Transform(value []string) []string {
+	trimmed := make([]string, len(value))
+	for i, item := range value {
+		trimmed[i] = strings.TrimSpace(item)
+	}
+	return trimmed
+}
+
+/**
+ * 当前字段遇到第一个验证错误后停止该字段后续验证，不影响其他字段
+ */
+func Bail(_ []string, _ string) bool {
+	return true
+}
+
 /**
  * 验证邮箱地址是否正确
  */
@@ -210,3 +249,421 @@ func In(value []string, arr string) bool {
 	}
 	return false
 }
+
+/**
+ * 验证整数是否为奇数
+ *
+ * @param value 需要验证的值
+ * @param param 自定义参数
+ * @return bool
+ */
+func Odd(value []string, _ string) bool {
+	val, ok := checkInt(value)
+	if !ok {
+		return false
+	}
+	return val%2 != 0
+}
+
+/**
+ * 验证整数是否为偶数
+ *
+ * @param value 需要验证的值
+ * @param param 自定义参数
+ * @return bool
+ */
+func Even(value []string, _ string) bool {
+	val, ok := checkInt(value)
+	if !ok {
+		return false
+	}
+	return val%2 == 0
+}
+
+/**
+ * 验证字符串是否为十六进制字符串（不带#前缀）
+ *
+ * @param value 需要验证的值
+ * @param param 可选，字符串长度，例如 sha256 为 64
+ * @return bool
+ */
+func HexString(value []string, param string) bool {
+	if len(value) <= 0 || len(value[0]) <= 0 {
+		return false
+	}
+	if len(param) > 0 {
+		length, err := strconv.Atoi(param)
+		if err != nil || len(value[0]) != length {
+			return false
+		}
+	}
+	for _, c := range value[0] {
+		if !unicode.Is(unicode.ASCII_Hex_Digit, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// 社会安全号码格式，分为区号、组号、序列号三部分
+var ssnPattern = regexp.MustCompile(`^(\d{3})-?(\d{2})-?(\d{4})$`)
+
+// 已公开或广告中使用过的无效社会安全号码
+var invalidSSNs = map[string]bool{
+	"078051120": true,
+	"219099999": true,
+	"123456789": true,
+	"457555462": true,
+}
+
+/**
+ * 验证美国社会安全号码(SSN)格式，支持 XXX-XX-XXXX 和 XXXXXXXXX 两种格式
+ *
+ * @param value 需要验证的值
+ * @param param 可选，strict 严格模式：额外拒绝已公开的无效号码以及9位数字全部相同的号码
+ * @return bool
+ */
+func SSN(value []string, param string) bool {
+	if len(value) <= 0 {
+		return false
+	}
+	ssn := value[0]
+	if len(ssn) != 9 && len(ssn) != 11 {
+		return false
+	}
+	matches := ssnPattern.FindStringSubmatch(ssn)
+	if matches == nil {
+		return false
+	}
+	// 不允许只有一个分隔符
+	if len(ssn) == 11 && (ssn[3] != '-' || ssn[6] != '-') {
+		return false
+	}
+
+	area, group, serial := matches[1], matches[2], matches[3]
+	if area == "000" || area == "666" || area[0] == '9' || group == "00" || serial == "0000" {
+		return false
+	}
+
+	if param == "strict" {
+		digits := area + group + serial
+		if invalidSSNs[digits] || strings.Count(digits, digits[:1]) == len(digits) {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	htmlTagPattern    = regexp.MustCompile("<[a-zA-Z!/?][^>]*>") // 标签名需紧跟 <，避免 1 < 2 and 3 > 2 误判
+	htmlEntityPattern = regexp.MustCompile("&(#[0-9]+|#[xX][0-9a-fA-F]+|[a-zA-Z][a-zA-Z0-9]*);")
+)
+
+/**
+ * 验证字符串中不包含HTML标签，多个值时每一项都会检测
+ *
+ * @param value 需要验证的值
+ * @param param 可选，strict 严格模式：同时拒绝HTML实体，例如 &amp;
+ * @return bool
+ */
+func HTMLFree(value []string, param string) bool {
+	for _, item := range value {
+		if htmlTagPattern.MatchString(item) {
+			return false
+		}
+		if param == "strict" && htmlEntityPattern.MatchString(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// 常见SQL注入特征，忽略大小写
+var sqlInjectionPattern = regexp.MustCompile(`(?i)(` +
+	`\bor\s+(['"]\w*['"]?|\d+)\s*=\s*['"\d]|` + // OR 1=1, OR 'a'='a，需要引号或数字，避免 Tom or Jerry = friends 误判
+	`\bunion\s+(all\s+)?select\b|` +
+	`['"\d]\s*--(\s|$)|` + // 注释需要紧跟引号或数字，例如 admin'-- ，避免 price -- today 误判
+	`['"\d]\s*/\*.*\*/|` +
+	`;\s*(drop|delete|insert|update|shutdown|truncate)\b|` +
+	`\bdrop\s+(table|database)\b|` +
+	`\btruncate\s+table\b|` +
+	`\bdelete\s+from\b|` +
+	`\binsert\s+into\b|` +
+	`\bexec(\s|\()+(xp_|sp_)|` +
+	`\b(sleep|benchmark|pg_sleep)\s*\(\s*\d|` + // SLEEP(5)，参数需要为数字，避免 sleep (soon) 误判
+	`\bwaitfor\s+delay\b` +
+	`)`)
+
+/**
+ * 检测字符串中是否包含常见的SQL注入特征，多个值时每一项都会检测
+ * 该规则仅作为验证层的预警手段，不能替代参数化查询
+ *
+ * @param value 需要验证的值
+ * @param param 自定义参数
+ * @return bool
+ */
+func NoSQLInjection(value []string, _ string) bool {
+	for _, item := range value {
+		if sqlInjectionPattern.MatchString(item) {
+			return false
+		}
+	}
+	return true
+}
+
+/**
+ * 验证字符串的香农熵(每个字符的比特数)是否不低于指定阈值
+ *
+ * @param value 需要验证的值
+ * @param param 熵阈值，例如 3.5
+ * @return bool
+ */
+func StrongEntropy(value []string, param string) bool {
+	threshold, err := strconv.ParseFloat(param, 64)
+	if err != nil || len(value) <= 0 || len(value[0]) <= 0 {
+		return false
+	}
+
+	counts := make(map[rune]int)
+	for _, c := range value[0] {
+		counts[c]++
+	}
+	total := float64(utf8.RuneCountInString(value[0]))
+	entropy := 0.0
+	for _, count := range counts {
+		p := float64(count) / total
+		entropy -= p * math.Log2(p)
+	}
+	return entropy >= threshold
+}
+
+//go:generate go run gen_passwords.go
+
+/**
+ * 验证密码不在常见弱密码列表中，内置列表为 common_passwords.txt 中的236个常见密码
+ * 注意：内置列表尚未覆盖前10000个常见密码，只能拦截排名最靠前的弱密码
+ * 密码经SHA-1摘要后，先按前5位定位分组，再比较剩余后缀；同时检测小写形式
+ *
+ * @param value 需要验证的值
+ * @param param 自定义参数
+ * @return bool
+ */
+func CommonPassword(value []string, _ string) bool {
+	if len(value) <= 0 {
+		return false
+	}
+	candidates := []string{value[0]}
+	if lower := strings.ToLower(value[0]); lower != value[0] {
+		candidates = append(candidates, lower)
+	}
+
+	for _, item := range candidates {
+		sum := sha1.Sum([]byte(item))
+		digest := strings.ToUpper(hex.EncodeToString(sum[:]))
+		for _, suffix := range commonPasswordHashes[digest[:5]] {
+			if suffix == digest[5:] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+/**
+ * 验证字符串是否为结构正确的JWT，不校验签名
+ *
+ * @param value 需要验证的值
+ * @param param 可选，要求header中alg声明为指定算法，例如 hs256
+ * @return bool
+ */
+func JWTFormat(value []string, param string) bool {
+	if len(value) <= 0 {
+		return false
+	}
+	parts := strings.Split(value[0], ".")
+	if len(parts) != 3 {
+		return false
+	}
+
+	decoded := make([][]byte, len(parts))
+	for i, part := range parts {
+		if len(part) <= 0 {
+			return false
+		}
+		data, err := base64.RawURLEncoding.DecodeString(part)
+		if err != nil {
+			return false
+		}
+		decoded[i] = data
+	}
+
+	if len(param) > 0 {
+		var header struct {
+			Alg string `json:"alg"`
+		}
+		if err := json.Unmarshal(decoded[0], &header); err != nil {
+			return false
+		}
+		return strings.EqualFold(header.Alg, param)
+	}
+	return true
+}
+
+/**
+ * 验证字符串是否为格式正确的XML
+ *
+ * @param value 需要验证的值
+ * @param param 可选，noentity 同时拒绝包含实体声明(<!ENTITY)的内容，防止XXE
+ * @return bool
+ */
+func XMLSafe(value []string, param string) bool {
+	if len(value) <= 0 || len(strings.TrimSpace(value[0])) <= 0 {
+		return false
+	}
+	if param == "noentity" && strings.Contains(strings.ToUpper(value[0]), "<!ENTITY") {
+		return false
+	}
+
+	// 只允许一个根元素，根元素之外只能有空白字符、注释、处理指令以及DOCTYPE
+	decoder := xml.NewDecoder(strings.NewReader(value[0]))
+	roots, depth := 0, 0
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return false
+		}
+		switch item := token.(type) {
+		case xml.StartElement:
+			if depth == 0 {
+				roots++
+				if roots > 1 {
+					return false
+				}
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			if depth == 0 && len(strings.TrimSpace(string(item))) > 0 {
+				return false
+			}
+		}
+	}
+	return roots == 1
+}
+
+/**
+ * 验证多值字段中至少有一项在指定集合中
+ *
+ * @param value 需要验证的值
+ * @param param 集合，多个用逗号分隔，例如 admin,editor
+ * @return bool
+ */
+func Intersection(value []string, param string) bool {
+	set := strings.Split(param, ",")
+	for _, item := range value {
+		for _, elem := range set {
+			if item == elem {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+/**
+ * 验证多值字段包含指定集合中的所有项
+ *
+ * @param value 需要验证的值
+ * @param param 集合，多个用逗号分隔，例如 read,write
+ * @return bool
+ */
+func Superset(value []string, param string) bool {
+	for _, elem := range strings.Split(param, ",") {
+		found := false
+		for _, item := range value {
+			if item == elem {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+/**
+ * 验证IP地址是否在指定网段内
+ *
+ * @param value 需要验证的值
+ * @param param CIDR网段，例如 10.0.0.0/8
+ * @return bool
+ */
+func IPInRange(value []string, param string) bool {
+	if len(value) <= 0 {
+		return false
+	}
+	_, cidr, err := net.ParseCIDR(param)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(value[0])
+	if ip == nil {
+		return false
+	}
+	return cidr.Contains(ip)
+}
+
+var coordinatePattern = regexp.MustCompile(`^[-+]?\d{1,3}(\.\d+)?$`)
+
+/**
+ * 验证纬度，取值范围 -90 ~ 90
+ *
+ * @param value 需要验证的值
+ * @param param 可选，precision:N 限制最多N位小数
+ * @return bool
+ */
+func Latitude(value []string, param string) bool {
+	return checkCoordinate(value, param, 90)
+}
+
+/**
+ * 验证经度，取值范围 -180 ~ 180
+ *
+ * @param value 需要验证的值
+ * @param param 可选，precision:N 限制最多N位小数
+ * @return bool
+ */
+func Longitude(value []string, param string) bool {
+	return checkCoordinate(value, param, 180)
+}
+
+func checkCoordinate(value []string, param string, limit float64) bool {
+	if len(value) <= 0 || !coordinatePattern.MatchString(value[0]) {
+		return false
+	}
+	coordinate, err := strconv.ParseFloat(value[0], 64)
+	if err != nil || coordinate < -limit || coordinate > limit {
+		return false
+	}
+
+	if len(param) > 0 {
+		if !strings.HasPrefix(param, "precision:") {
+			return false
+		}
+		precision, err := strconv.Atoi(strings.TrimPrefix(param, "precision:"))
+		if err != nil {
+			return false
+		}
+		// 小数位数超过限制，例如伪造的GPS坐标通常带有16位以上小数
+		if parts := strings.SplitN(value[0], ".", 2); len(parts) == 2 && len(parts[1]) > precision {
+			return false
+		}
+	}
+	return true
+}
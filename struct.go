@@ -0,0 +1,190 @@
+package validator
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+)
+
+// 自校验接口，实现该接口的结构体字段会在 ValidateStruct 中调用 Validate
+type SelfValidator interface {
+	Validate() error
+}
+
+// 字段自校验错误
+type selfError struct {
+	field string
+	err   error
+}
+
+/**
+ * 验证结构体，通过字段标签配置验证规则
+ *
+ *     type User struct {
+ *         Name    string `form:"name" valid:"min:1|max:10"`
+ *         Address Address // 嵌套结构体字段路径为 Address.xxx
+ *     }
+ *
+ * form 标签为字段名称，默认为结构体字段名；valid 标签为验证规则
+ * 实现 SelfValidator 接口的字段会调用 Validate，返回的错误以 validate 为key合并到该字段的 ValidErrors
+ * 配置了 valid 标签且实现 encoding.TextMarshaler 或 fmt.Stringer 接口的结构体字段(例如 time.Time)序列化后验证，不会展开
+ *
+ * @param s interface{} 结构体或结构体指针
+ * @param opts 可选配置项
+ * @return Validator, error 默认返回验证错误第一项
+ */
+func ValidateStruct(s interface{}, opts ...Option) (*Validator, error) {
+	val := reflect.ValueOf(s)
+	for val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		panic("ValidateStruct only support struct or pointer to struct")
+	}
+	// 传值时复制为可寻址的值，以便调用指针接收者实现的 Validate
+	if !val.CanAddr() {
+		addressable := reflect.New(val.Type()).Elem()
+		addressable.Set(val)
+		val = addressable
+	}
+
+	data := make(map[string][]string)
+	fmtRules := make(map[string][]string)
+	var selfErrors []selfError
+	collectStruct(val, "", data, fmtRules, &selfErrors)
+
+	var validator *Validator
+	var err error
+	if len(fmtRules) > 0 {
+		validator, err = New(data, fmtRules, opts...)
+	} else {
+		validator = &Validator{data: data, rules: fmtRules, recoverRule: defaultRecoverRule}
+		for _, opt := range opts {
+			opt(validator)
+		}
+	}
+
+	for _, item := range selfErrors {
+		validator.insertError("validate", item.field, item.err.Error(), "validate")
+	}
+	if err == nil && len(selfErrors) > 0 {
+		err = selfErrors[0].err
+	}
+	return validator, err
+}
+
+/**
+ * 收集结构体字段的验证数据、验证规则以及自校验错误
+ *
+ * @param val reflect.Value 结构体
+ * @param prefix string 嵌套结构体字段路径前缀
+ */
+func collectStruct(val reflect.Value, prefix string, data map[string][]string, fmtRules map[string][]string, selfErrors *[]selfError) {
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" { // 未导出字段
+			continue
+		}
+
+		name := field.Tag.Get("form")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+
+		fieldVal := val.Field(i)
+		if err := selfValidate(fieldVal); err != nil {
+			*selfErrors = append(*selfErrors, selfError{field: name, err: err})
+		}
+
+		tag, hasTag := field.Tag.Lookup("valid")
+		if hasTag {
+			fmtRules[name] = formatRules(map[string]string{name: tag})[name]
+		}
+
+		for fieldVal.Kind() == reflect.Ptr {
+			if fieldVal.IsNil() {
+				break
+			}
+			fieldVal = fieldVal.Elem()
+		}
+		if fieldVal.Kind() == reflect.Ptr {
+			continue // nil 指针视为字段不存在
+		}
+
+		switch fieldVal.Kind() {
+		case reflect.Struct:
+			// 配置了验证规则的 time.Time 等可以序列化的结构体作为字段值验证，不再展开
+			if str, ok := marshalValue(fieldVal); ok && hasTag {
+				data[name] = []string{str}
+				continue
+			}
+			// 配置了验证规则的嵌套结构体记录字段存在，例如 required
+			if hasTag {
+				data[name] = []string{fmt.Sprint(fieldVal.Interface())}
+			}
+			collectStruct(fieldVal, name, data, fmtRules, selfErrors)
+		case reflect.Slice, reflect.Array:
+			values := make([]string, fieldVal.Len())
+			for j := 0; j < fieldVal.Len(); j++ {
+				values[j] = fmt.Sprint(fieldVal.Index(j).Interface())
+			}
+			data[name] = values
+		default:
+			data[name] = []string{fmt.Sprint(fieldVal.Interface())}
+		}
+	}
+}
+
+/**
+ * 如果字段实现了 SelfValidator 接口则调用 Validate
+ *
+ * @param val reflect.Value
+ * @return error
+ */
+func selfValidate(val reflect.Value) error {
+	if val.Kind() == reflect.Ptr && val.IsNil() {
+		return nil
+	}
+	if item, ok := val.Interface().(SelfValidator); ok {
+		return item.Validate()
+	}
+	if val.CanAddr() {
+		if item, ok := val.Addr().Interface().(SelfValidator); ok {
+			return item.Validate()
+		}
+	}
+	return nil
+}
+
+/**
+ * 如果字段实现了 encoding.TextMarshaler 或 fmt.Stringer 接口则序列化为字符串
+ *
+ * @param val reflect.Value
+ * @return string, bool
+ */
+func marshalValue(val reflect.Value) (string, bool) {
+	item := val.Interface()
+	if val.CanAddr() {
+		if _, ok := item.(encoding.TextMarshaler); !ok {
+			if _, ok := item.(fmt.Stringer); !ok {
+				item = val.Addr().Interface()
+			}
+		}
+	}
+	if marshaler, ok := item.(encoding.TextMarshaler); ok {
+		if text, err := marshaler.MarshalText(); err == nil {
+			return string(text), true
+		}
+	}
+	if stringer, ok := item.(fmt.Stringer); ok {
+		return stringer.String(), true
+	}
+	return "", false
+}
@@ -0,0 +1,81 @@
+package testutil
+
+import (
+	"testing"
+
+	"github.com/ntt360/validator"
+)
+
+/**
+ * 断言验证通过
+ *
+ * @param t testing.TB
+ * @param data map[string][]string 验证的值
+ * @param rules 验证规则
+ * @param opts 可选配置项
+ * @return *validator.Validator
+ */
+func AssertValid(t testing.TB, data map[string][]string, rules interface{}, opts ...validator.Option) *validator.Validator {
+	t.Helper()
+	v, err := validator.New(data, rules, opts...)
+	if err != nil {
+		t.Fatalf("expect data to be valid, got error: %v, all errors: %v", err, v.ValidErrors)
+	}
+	return v
+}
+
+/**
+ * 断言验证不通过，返回的验证器可以继续用于 AssertFieldError
+ *
+ * @param t testing.TB
+ * @param data map[string][]string 验证的值
+ * @param rules 验证规则
+ * @param opts 可选配置项
+ * @return *validator.Validator
+ */
+func AssertInvalid(t testing.TB, data map[string][]string, rules interface{}, opts ...validator.Option) *validator.Validator {
+	t.Helper()
+	v, err := validator.New(data, rules, opts...)
+	if err == nil {
+		t.Fatalf("expect data to be invalid, but validation passed: %v", data)
+	}
+	return v
+}
+
+/**
+ * 断言字段存在指定规则的验证错误
+ *
+ * @param t testing.TB
+ * @param v *validator.Validator
+ * @param field string 验证字段
+ * @param rule string 验证规则
+ */
+func AssertFieldError(t testing.TB, v *validator.Validator, field string, rule string) {
+	t.Helper()
+	for _, item := range v.ValidErrors {
+		if item.Field != field {
+			continue
+		}
+		if _, ok := item.Errors[rule]; !ok {
+			t.Fatalf("expect field %s to fail rule %s, got errors: %v", field, rule, item.Errors)
+		}
+		return
+	}
+	t.Fatalf("expect field %s to fail rule %s, but the field has no errors", field, rule)
+}
+
+/**
+ * 断言字段不存在验证错误
+ *
+ * @param t testing.TB
+ * @param v *validator.Validator
+ * @param field string 验证字段
+ */
+func AssertNoFieldError(t testing.TB, v *validator.Validator, field string) {
+	t.Helper()
+	for _, item := range v.ValidErrors {
+		if item.Field == field {
+			t.Fatalf("expect field %s to be valid, got errors: %v", field, item.Errors)
+		}
+	}
+}
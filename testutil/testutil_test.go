@@ -0,0 +1,16 @@
+package testutil
+
+import "testing"
+
+func TestAssert(t *testing.T) {
+	rules := map[string]string{
+		"name": "min:1|max:10",
+		"age":  "int",
+	}
+
+	AssertValid(t, map[string][]string{"name": {"banana"}, "age": {"18"}}, rules)
+
+	v := AssertInvalid(t, map[string][]string{"name": {"banana"}, "age": {"abc"}}, rules)
+	AssertFieldError(t, v, "age", "int")
+	AssertNoFieldError(t, v, "name")
+}
@@ -0,0 +1,55 @@
+package validator
+
+import "sync"
+
+// 限流函数，key为验证规则名称
+var (
+	throttleMap  = map[string]func(field, value string) bool{}
+	throttleLock sync.RWMutex
+)
+
+/**
+ * 注册验证规则限流函数，在执行该规则前调用，例如配合Redis限制优惠码验证频率
+ * fn 返回false时跳过该字段后续验证，并以 throttle 为key记录错误，
+ * 错误提示可以通过 "字段.throttle" 自定义，可以在验证过程中并发调用
+ *
+ * @param ruleName string 验证规则名称
+ * @param fn func(field, value string) bool 返回true表示允许验证
+ */
+func RegisterThrottle(ruleName string, fn func(field, value string) bool) {
+	throttleLock.Lock()
+	defer throttleLock.Unlock()
+	throttleMap[ucfirst(ruleName)] = fn
+}
+
+/**
+ * 获取验证规则的限流函数
+ *
+ * @param ruleName string 验证规则名称
+ * @return func(field, value string) bool, bool
+ */
+func getThrottle(ruleName string) (func(field, value string) bool, bool) {
+	throttleLock.RLock()
+	defer throttleLock.RUnlock()
+	fn, ok := throttleMap[ucfirst(ruleName)]
+	return fn, ok
+}
+
+/**
+ * 检测字段是否允许执行指定验证规则
+ *
+ * @param key {string} 需要验证的字段
+ * @param ruleName {string} 验证规则
+ * @return bool
+ */
+func (v *Validator) allow(key string, ruleName string) bool {
+	fn, ok := getThrottle(ruleName)
+	if !ok {
+		return true
+	}
+	value := ""
+	if item := v.data[key]; len(item) > 0 {
+		value = item[0]
+	}
+	return fn(key, value)
+}
@@ -1,73 +1,271 @@
 package validator
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"github.com/ntt360/validator/rules"
+	"log/slog"
+	"net/url"
 	"reflect"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 )
 
 // 内置验证器
 var validateMap = map[string]interface{}{
-	"Required": rules.Required,
-	"Min":      rules.Min,
-	"Max":      rules.Max,
-	"Regex":    rules.Regex,
-	"Int":      rules.Int,
-	"Numeric":  rules.Numeric,
-	"Nullable": rules.Nullable,
-	"Email":    rules.Email,
-	"Url":      rules.Url,
-	"Mobile":   rules.Mobile,
-	"In":       rules.In,
-	"Lt":       rules.Lt,
-	"Lte":      rules.Lte,
-	"Gt":       rules.Gt,
-	"Gte":      rules.Gte,
+	"Required":       rules.Required,
+	"Min":            rules.Min,
+	"Max":            rules.Max,
+	"Regex":          rules.Regex,
+	"Int":            rules.Int,
+	"Numeric":        rules.Numeric,
+	"Nullable":       rules.Nullable,
+	"Bail":           rules.Bail,
+	"Trim":           rules.Trim,
+	"Email":          rules.Email,
+	"Url":            rules.Url,
+	"Mobile":         rules.Mobile,
+	"In":             rules.In,
+	"Lt":             rules.Lt,
+	"Lte":            rules.Lte,
+	"Gt":             rules.Gt,
+	"Gte":            rules.Gte,
+	"Odd":            rules.Odd,
+	"Even":           rules.Even,
+	"Hexstring":      rules.HexString,
+	"Creditcard":     rules.CreditCard,
+	"Iban":           rules.IBAN,
+	"Bic":            rules.BIC,
+	"Ean":            rules.EAN,
+	"Isbn":           rules.ISBN,
+	"Ssn":            rules.SSN,
+	"Htmlfree":       rules.HTMLFree,
+	"Nosqlinjection": rules.NoSQLInjection,
+	"Strongentropy":  rules.StrongEntropy,
+	"Commonpassword": rules.CommonPassword,
+	"Jwtformat":      rules.JWTFormat,
+	"Xmlsafe":        rules.XMLSafe,
+	"Intersection":   rules.Intersection,
+	"Superset":       rules.Superset,
+	"Ipinrange":      rules.IPInRange,
+	"Latitude":       rules.Latitude,
+	"Longitude":      rules.Longitude,
+	"Atleastone":     atLeastOne,
+	"Ltfield":        rules.LtField,
+	"Ltefield":       rules.LteField,
+	"Gtfield":        rules.GtField,
+	"Gtefield":       rules.GteField,
+	"Same":           rules.Same,
+	"Different":      rules.Different,
+}
+
+// 非幂等验证规则，相同数据重复验证结果可能不一致，例如依赖数据库的规则，key为规则名称(首字母大写)
+var (
+	nonIdempotentRules = map[string]bool{}
+	nonIdempotentLock  sync.RWMutex
+)
+
+// 组合验证规则，由验证器在 parse 中直接处理
+var metaRules = map[string]bool{
+	"Or":      true,
+	"Depends": true,
+}
+
+// 隐式验证规则，字段不存在或为空时仍然执行，包含该规则的字段其他规则按 nullable 处理
+var implicitRules = map[string]bool{
+	"Atleastone": true,
+}
+
+// 转换接口，实现该接口的验证器在验证后会使用 Transform 的返回值更新验证数据
+type ValueTransformer interface {
+	Transform([]string) []string
 }
 
 // 单个验证字段错误提示
 type ValidError struct {
-	Field  string
-	Errors map[string]string
+	Field      string
+	Errors     map[string]string
+	ErrorCodes map[string]string // 错误码，与Errors使用相同的key
 }
 
 type CustomMsgElem map[string]string
 
+// 单个字段的自定义错误码，key为验证规则名称或def
+type CustomCodeElem map[string]string
+
 type Validator struct {
-	data      map[string][]string      // 需要验证的数据
-	rules     map[string][]string      // 验证规则
-	customMsg map[string]CustomMsgElem // 自定义错误
+	data       map[string][]string       // 需要验证的数据
+	rules      map[string][]string       // 验证规则
+	customMsg  map[string]CustomMsgElem  // 自定义错误
+	customCode map[string]CustomCodeElem // 自定义错误码
+
+	message       map[string]string   // 待解析的自定义错误
+	ctx           context.Context     // 上下文，取消后停止验证
+	trimAll       bool                // 验证前去除所有值首尾空白
+	dataCopied    bool                // 验证数据是否已复制，转换类验证器修改数据前需要先复制，避免修改调用方的数据
+	bail          bool                // 首个验证错误后停止验证
+	locale        string              // 默认错误提示语言
+	logger        *slog.Logger        // 规则执行日志
+	maxRuntime    time.Duration       // 最长验证时间，超时后停止验证
+	reportUnknown bool                // 是否报告未配置验证规则的字段
+	recoverRule   bool                // 验证规则不存在时记录验证错误而不是panic
+	fields        []string            // 仅验证指定字段
+	missingRules  map[string][]string // 需要检测缺失的验证规则，为nil时使用 rules
+	allRules      map[string][]string // WithFields 筛选前的全部验证规则，为nil时使用 rules
+
+	idempotencyCheck bool              // 相同数据重复验证时使用缓存结果
+	cached           *idempotentResult // 上次验证结果
 
 	ValidErrors []ValidError // 验证错误
+	Warnings    []ValidError // 验证警告，不影响验证结果
 }
 
 /**
- * 不带自定义错误验证
+ * 创建验证器并执行验证
  *
  * @param data map[string][]string 验证的值
  * @param rules map[string]string  验证规则
+ * @param opts 可选配置项，例如 WithMessages 自定义错误提示
  * @return Validator, error 默认返回验证错误第一项
  */
-func New(data map[string][]string, rules interface{}, args ...map[string]string) (*Validator, error) {
-	message := make(map[string]string)
-	if len(args) > 0 {
-		message = args[0]
+func New(data map[string][]string, rules interface{}, opts ...Option) (*Validator, error) {
+	validator := Validator{data: data, rules: formatRules(rules), recoverRule: defaultRecoverRule}
+	for _, opt := range opts {
+		opt(&validator)
+	}
+	if validator.trimAll {
+		validator.data = trimData(data)
+		validator.dataCopied = true
+	}
+	if validator.fields != nil {
+		validator.selectFields()
+	}
+
+	return validator.validate()
+}
+
+/**
+ * 执行验证，开启 WithIdempotencyCheck 时相同数据重复验证使用缓存结果
+ *
+ * @return Validator, error 默认返回验证错误第一项
+ */
+func (v *Validator) validate() (*Validator, error) {
+	if !v.idempotencyCheck || !v.rulesIdempotent() {
+		return v.check()
+	}
+
+	// 验证数据和规则未变化时直接使用上次的验证结果
+	hash := v.contentHash()
+	if v.cached != nil && v.cached.hash == hash {
+		v.ValidErrors = v.cached.validErrors
+		v.Warnings = v.cached.warnings
+		return v, v.cached.err
 	}
-	fmtRules := formatRules(rules)
-	validator := Validator{data: data, rules: fmtRules}
-	if ok := validator.missingCheck(data, fmtRules); !ok {
+	_, err := v.check()
+	v.cached = &idempotentResult{hash: hash, validErrors: v.ValidErrors, warnings: v.Warnings, err: err}
+	return v, err
+}
+
+/**
+ * 检测缺失字段并执行验证规则
+ *
+ * @return Validator, error 默认返回验证错误第一项
+ */
+func (v *Validator) check() (*Validator, error) {
+	missingRules := v.rules
+	if v.missingRules != nil {
+		missingRules = v.missingRules
+	}
+	if ok := v.missingCheck(v.data, missingRules); !ok {
 		// 获取错误的第一项作为返回值
-		err := validator.ValidErrors[0]
+		err := v.ValidErrors[0]
 		val, ok := err.Errors["def"]
 		if !ok {
 			val = "missing valid error"
 		}
-		return &validator, errors.New(val)
+		return v, errors.New(val)
+	}
+	v.parseMessage(v.message)
+
+	return v.run()
+}
+
+/**
+ * 清空验证错误、警告以及 WithIdempotencyCheck 缓存的验证结果，可以链式调用
+ *
+ * @return Validator
+ */
+func (v *Validator) Reset() *Validator {
+	v.clearErrors()
+	v.cached = nil
+	return v
+}
+
+/**
+ * 使用相同的验证数据和规则重新验证，验证数据修改后可以复用验证器
+ * 与 Reset 不同，不会清空 WithIdempotencyCheck 缓存，验证数据和规则未变化时直接返回上次的验证结果
+ * 注意：使用 WithTrimAll 或 trim 等转换类规则时验证数据为副本，修改原始数据不会生效
+ *
+ * @return Validator, error 默认返回验证错误第一项
+ */
+func (v *Validator) ReRun() (*Validator, error) {
+	v.clearErrors()
+	return v.validate()
+}
+
+// 清空验证错误及警告
+func (v *Validator) clearErrors() {
+	v.ValidErrors = nil
+	v.Warnings = nil
+}
+
+/**
+ * 带自定义错误验证
+ *
+ * Deprecated: 请使用 New(data, rules, WithMessages(message))
+ *
+ * @param data map[string][]string 验证的值
+ * @param rules map[string]string  验证规则
+ * @param args map[string]string 自定义错误提示
+ * @return Validator, error 默认返回验证错误第一项
+ */
+func NewWithMessages(data map[string][]string, rules interface{}, args ...map[string]string) (*Validator, error) {
+	var opts []Option
+	if len(args) > 0 {
+		opts = append(opts, WithMessages(args[0]))
 	}
-	validator.parseMessage(message)
+	return New(data, rules, opts...)
+}
 
-	return validator.run()
+/**
+ * 与 New 相同，但验证不通过或出错时直接panic，适用于测试以及初始化代码
+ *
+ * @param data map[string][]string 验证的值
+ * @param rules map[string]string  验证规则
+ * @param opts 可选配置项
+ * @return Validator
+ */
+func MustNew(data map[string][]string, rules interface{}, opts ...Option) *Validator {
+	validator, err := New(data, rules, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return validator
+}
+
+/**
+ * 直接验证 net/http 表单数据，不会复制数据
+ *
+ * @param values url.Values 验证的值，例如 r.Form
+ * @param rules map[string]string  验证规则
+ * @param opts 可选配置项
+ * @return Validator, error 默认返回验证错误第一项
+ */
+func NewFromURLValues(values url.Values, rules interface{}, opts ...Option) (*Validator, error) {
+	return New(map[string][]string(values), rules, opts...)
 }
 
 func formatRules(rules interface{}) map[string][]string {
@@ -108,8 +306,32 @@ func formatRules(rules interface{}) map[string][]string {
 }
 
 func (v *Validator) run() (*Validator, error) {
-	for key, item := range v.rules {
-		v.parse(key, item)
+	var deadline time.Time
+	if v.maxRuntime > 0 {
+		deadline = time.Now().Add(v.maxRuntime)
+	}
+	for _, key := range v.sortFields() {
+		if v.ctx != nil {
+			if err := v.ctx.Err(); err != nil {
+				return v, err
+			}
+		}
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			// 超时后以 timeout 为key记录未验证的字段
+			err := fmt.Errorf("validation exceeded max runtime %s: %w", v.maxRuntime, context.DeadlineExceeded)
+			v.insertError("timeout", key, err.Error(), "timeout")
+			return v, err
+		}
+		if v.dependencyFailed(key) {
+			continue
+		}
+		v.parse(key, v.rules[key])
+		if v.bail && len(v.ValidErrors) > 0 {
+			break
+		}
+	}
+	if v.reportUnknown {
+		v.unknownFieldCheck()
 	}
 
 	if v.ValidErrors != nil || len(v.ValidErrors) > 0 {
@@ -129,42 +351,120 @@ func (v *Validator) run() (*Validator, error) {
 
 func (v *Validator) parse(key string, rules []string) {
 	for _, rule := range rules {
-		flagIndex := strings.Split(rule, ":")
-		param := ""
-		ruleName := rule
-		if len(flagIndex) > 1 {
-			ruleName = flagIndex[0]
-			param = flagIndex[1]
-		}
+		ruleName, param := splitRule(rule)
 
-		if _, ok := validateMap[ucfirst(ruleName)]; !ok {
-			panic(ruleName + "the valid rule not exist")
+		if !ruleExists(ruleName) {
+			v.unknownRule(key, ruleName)
+			continue
+		}
+		if ucfirst(ruleName) == "Depends" { // 依赖声明不参与验证
+			continue
 		}
 
-		if v.isVerifiable(key, rules) {
-			dynamicFunc := reflect.ValueOf(validateMap[ucfirst(ruleName)])
-			if dynamicFunc.IsValid() {
-				value := v.data[key]
-				arguments := make([]reflect.Value, 2) // 传递2个固定参数
-				arguments[0] = reflect.ValueOf(value)
-				arguments[1] = reflect.ValueOf(param)
-				result := dynamicFunc.Call(arguments)
-				ok := result[0].Interface().(bool)
-				if !ok {
-					v.addErrors(key, ruleName, value)
+		if implicitRules[ucfirst(ruleName)] || v.isVerifiable(key, rules) {
+			// 限流时跳过当前字段后续验证，并以 throttle 为key记录错误
+			if !v.allow(key, ruleName) {
+				v.addErrors(key, "throttle", ruleName)
+				return
+			}
+			var ok bool
+			if ucfirst(ruleName) == "Or" {
+				ok = v.or(key, param)
+			} else {
+				ok = v.call(ruleName, key, param)
+			}
+			if v.logger != nil {
+				v.logger.Debug("validator rule executed", "field", key, "rule", ruleName, "passed", ok)
+			}
+			if !ok {
+				v.addErrors(key, ruleName, param)
+				// 全局bail停止所有验证，字段bail仅停止当前字段的后续验证
+				if v.bail || inArray(rules, "bail") {
+					return
 				}
 			}
 		}
 	}
 }
 
+/**
+ * 调用内置验证器验证字段
+ *
+ * @param ruleName {string} 验证规则
+ * @param key {string} 需要验证的字段
+ * @param param {string} 验证规则参数
+ * @return bool
+ */
+func (v *Validator) call(ruleName string, key string, param string) bool {
+	validateFunc := validateMap[ucfirst(ruleName)]
+	dynamicFunc := reflect.ValueOf(validateFunc)
+	if !dynamicFunc.IsValid() {
+		return true
+	}
+	value := v.data[key]
+	arguments := make([]reflect.Value, 2) // 传递2个固定参数
+	arguments[0] = reflect.ValueOf(value)
+	arguments[1] = reflect.ValueOf(param)
+	if dynamicFunc.Type().NumIn() == 3 { // 需要访问其他字段的验证器额外传递全部验证数据
+		arguments = append(arguments, reflect.ValueOf(v.data))
+	}
+	result := dynamicFunc.Call(arguments)
+
+	// 转换类验证器更新验证数据，后续规则使用转换后的值
+	if transformer, ok := validateFunc.(ValueTransformer); ok {
+		if _, exist := v.data[key]; exist {
+			if !v.dataCopied {
+				v.data = copyData(v.data)
+				v.dataCopied = true
+			}
+			v.data[key] = transformer.Transform(value)
+		}
+	}
+	return result[0].Interface().(bool)
+}
+
+/**
+ * 组合验证，任意一个子规则验证通过即通过，例如 or:email,mobile
+ * 子规则之间使用逗号分隔，因此子规则参数中不能包含逗号
+ *
+ * @param key {string} 需要验证的字段
+ * @param param {string} 子规则列表
+ * @return bool
+ */
+func (v *Validator) or(key string, param string) bool {
+	for _, rule := range strings.Split(param, ",") {
+		ruleName, ruleParam := splitRule(rule)
+		if _, ok := validateMap[ucfirst(ruleName)]; !ok {
+			v.unknownRule(key, ruleName)
+			continue
+		}
+		if v.call(ruleName, key, ruleParam) {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * 处理不存在的验证规则，根据配置panic或记录验证错误
+ *
+ * @param key {string} 需要验证的字段
+ * @param ruleName {string} 验证规则
+ */
+func (v *Validator) unknownRule(key string, ruleName string) {
+	if !v.recoverRule {
+		panic(ruleName + "the valid rule not exist")
+	}
+	v.insertError(ruleName, key, v.localeMessage("unknownrule", key, ruleName), ruleName)
+}
+
 /**
  * 处理错误数据
  *
  * @param key
  * @param rule
  */
-func (v *Validator) addErrors(field string, rule string, value []string) {
+func (v *Validator) addErrors(field string, rule string, param string) {
 	customMsg, exist := v.customMsg[field] // 获取是否对验证字段存在自定义错误提示
 	if exist {
 		// 检测是否存在默认值, 字段优先级高于其他优先级
@@ -175,13 +475,13 @@ func (v *Validator) addErrors(field string, rule string, value []string) {
 		// 检测是否存在具体匹配错误内容
 		fieldMsg, fieldOk := customMsg[rule]
 		if !fieldOk {
-			v.notExistCustomInsert(field, rule)
+			v.notExistCustomInsert(field, rule, param)
 		} else {
 			key := rule
 			v.insertError(key, field, fieldMsg, rule)
 		}
 	} else {
-		v.notExistCustomInsert(field, rule)
+		v.notExistCustomInsert(field, rule, param)
 	}
 }
 
@@ -190,9 +490,10 @@ func (v *Validator) addErrors(field string, rule string, value []string) {
  *
  * @param field {string} 需要验证的字段
  * @param rule {string} 验证规则
+ * @param param {string} 验证规则参数
  */
-func (v *Validator) notExistCustomInsert(field string, rule string) {
-	msg := "the field " + field + " not valid in " + rule
+func (v *Validator) notExistCustomInsert(field string, rule string, param string) {
+	msg := v.localeMessage(rule, field, param)
 	key := rule
 	v.insertError(key, field, msg, rule)
 }
@@ -204,24 +505,42 @@ func (v *Validator) notExistCustomInsert(field string, rule string) {
  * @param rule {string} 验证规则
  */
 func (v *Validator) insertError(key string, field string, msg string, rule string) {
-	if v.ValidErrors == nil {
-		itemErrors := make(map[string]string)
-		itemErrors[key] = msg
-		validErrItem := ValidError{Field: field, Errors: itemErrors}
-		v.ValidErrors = []ValidError{validErrItem}
-	} else {
-		index := v.existError(field)
-		if index >= 0 {
-			v.ValidErrors[index].Errors[key] = msg
-		} else {
-			itemErrors := make(map[string]string)
-			itemErrors[key] = msg
-			newValidErr := ValidError{Field: field, Errors: itemErrors}
-			v.ValidErrors = append(v.ValidErrors, newValidErr)
+	index := v.existError(field)
+	if index < 0 {
+		v.ValidErrors = append(v.ValidErrors, ValidError{Field: field, Errors: make(map[string]string)})
+		index = len(v.ValidErrors) - 1
+	}
+	v.ValidErrors[index].Errors[key] = msg
+
+	if code, ok := v.errorCode(key, field, rule); ok {
+		if v.ValidErrors[index].ErrorCodes == nil {
+			v.ValidErrors[index].ErrorCodes = make(map[string]string)
 		}
+		v.ValidErrors[index].ErrorCodes[key] = code
 	}
 }
 
+/**
+ * 获取自定义错误码，优先匹配与错误提示相同的key，其次为具体规则，最后为def
+ *
+ * @param key {string} 错误提示key
+ * @param field {string} 需要验证的字段
+ * @param rule {string} 验证规则
+ * @return string, bool
+ */
+func (v *Validator) errorCode(key string, field string, rule string) (string, bool) {
+	codes, exist := v.customCode[field]
+	if !exist {
+		return "", false
+	}
+	for _, name := range []string{key, rule, "def"} {
+		if code, ok := codes[name]; ok {
+			return code, true
+		}
+	}
+	return "", false
+}
+
 /**
  * 获取错误数组中索引
  *
@@ -238,6 +557,49 @@ func (v *Validator) existError(field string) int {
 	return -1
 }
 
+/**
+ * 未配置验证规则的字段添加到验证警告中
+ */
+func (v *Validator) unknownFieldCheck() {
+	allRules := v.rules
+	if v.allRules != nil {
+		allRules = v.allRules
+	}
+	keys := make([]string, 0, len(v.data))
+	for key := range v.data {
+		if _, ok := allRules[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		v.Warnings = append(v.Warnings, ValidError{
+			Field:  key,
+			Errors: map[string]string{"unknown": v.localeMessage("unknown", key, "")},
+		})
+	}
+}
+
+/**
+ * 仅保留 WithFields 指定字段的验证规则
+ * 未选中但包含 required 规则的字段仍需要检测是否缺失
+ */
+func (v *Validator) selectFields() {
+	selected := make(map[string][]string, len(v.fields))
+	missingRules := make(map[string][]string)
+	for key, item := range v.rules {
+		if inArray(v.fields, key) {
+			selected[key] = item
+			missingRules[key] = item
+		} else if inArray(item, "required") {
+			missingRules[key] = item
+		}
+	}
+	v.allRules = v.rules
+	v.rules = selected
+	v.missingRules = missingRules
+}
+
 /**
  * 检测是否需要验证
  *
@@ -247,7 +609,7 @@ func (v *Validator) existError(field string) int {
  */
 func (v *Validator) isVerifiable(key string, rules []string) bool {
 	rule, ok := v.data[key]
-	if inArray(rules, "nullable") {
+	if inArray(rules, "nullable") || hasImplicitRule(rules) {
 		if !ok {
 			return false
 		} else if rule != nil {
@@ -271,12 +633,13 @@ func (v *Validator) parseMessage(message map[string]string) {
 		return
 	}
 	for key, item := range message {
-		if strings.Contains(key, ".") {
-			itemArr := strings.Split(key, ".")
-			field := itemArr[0]
-			rule := itemArr[1]
+		// 嵌套结构体以及INI分组的字段名称包含 . ，完整key为字段名称时作为字段默认错误，否则按最后一个 . 分隔字段和规则
+		if _, isField := v.data[key]; !isField && strings.Contains(key, ".") {
+			index := strings.LastIndex(key, ".")
+			field := key[:index]
+			rule := key[index+1:]
 			_, ok := v.data[field]
-			if _, exist := validateMap[ucfirst(rule)]; exist && ok {
+			if exist := ruleExists(rule) || rule == "throttle"; exist && ok {
 				v.addMessage(field, rule, item)
 			}
 		} else {
@@ -320,6 +683,49 @@ func (v *Validator) addMessage(field string, rule string, message string) {
 
 }
 
+/**
+ * 拆分验证规则名称与参数，仅按第一个冒号拆分，参数中可以包含冒号
+ *
+ * @param rule
+ * @return string, string
+ */
+func splitRule(rule string) (string, string) {
+	flagIndex := strings.SplitN(rule, ":", 2)
+	if len(flagIndex) > 1 {
+		return flagIndex[0], flagIndex[1]
+	}
+	return rule, ""
+}
+
+/**
+ * 检测验证规则是否存在
+ *
+ * @param ruleName
+ * @return bool
+ */
+func ruleExists(ruleName string) bool {
+	if _, ok := validateMap[ucfirst(ruleName)]; ok {
+		return true
+	}
+	return metaRules[ucfirst(ruleName)]
+}
+
+/**
+ * 检测验证规则中是否包含隐式验证规则
+ *
+ * @param rules
+ * @return bool
+ */
+func hasImplicitRule(rules []string) bool {
+	for _, rule := range rules {
+		ruleName, _ := splitRule(rule)
+		if len(ruleName) > 0 && implicitRules[ucfirst(ruleName)] {
+			return true
+		}
+	}
+	return false
+}
+
 /**
  * 字符串首字母大写转换
  *
@@ -327,6 +733,9 @@ func (v *Validator) addMessage(field string, rule string, message string) {
  * @return string
  */
 func ucfirst(str string) string {
+	if len(str) == 0 {
+		return str
+	}
 	return strings.ToUpper(str[0:1]) + str[1:]
 }
 
@@ -353,14 +762,14 @@ func inArray(arr []string, elem string) bool {
  * @param rules map[string]string 验证规则
  */
 func (v *Validator) missingCheck(data map[string][]string, rules map[string][]string) bool {
-	if len(rules) == 0 {
+	// WithFields 仅选择了未配置验证规则的字段时没有需要验证的规则，不视为配置错误
+	if len(rules) == 0 && v.fields == nil {
 		panic("验证规则不存在")
-		return false
 	}
 	for key, item := range rules {
 		_, ok := data[key]
-		if !inArray(item, "nullable") && !ok {
-			msg := "the param " + key + " not valid!"
+		if !inArray(item, "nullable") && !hasImplicitRule(item) && !ok {
+			msg := v.localeMessage("missing", key, "")
 			v.insertError("def", key, msg, "no")
 		}
 	}